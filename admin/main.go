@@ -2,17 +2,29 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/Scrimzay/supplychain/certs"
 	"github.com/Scrimzay/supplychain/supplychain"
 )
 
 func main() {
-	conn, err := grpc.Dial("localhost:8089", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	var tlsOpts certs.ClientOptions
+	flag.BoolVar(&tlsOpts.TLS, "tls", false, "Connect with TLS")
+	flag.StringVar(&tlsOpts.CAFile, "cacert", "", "CA file to check the server certificate against")
+	flag.StringVar(&tlsOpts.CertFile, "tlscert", "", "Client certificate file")
+	flag.StringVar(&tlsOpts.KeyFile, "tlskey", "", "Client certificate key file")
+	flag.Parse()
+
+	transport, err := certs.DialOption(tlsOpts)
+	if err != nil {
+		log.Fatalf("Failed to load TLS settings: %v", err)
+	}
+	conn, err := grpc.Dial("localhost:8089", transport)
 	if err != nil {
 		log.Fatalf("Failed to connect: %v", err)
 	}
@@ -39,9 +51,24 @@ func main() {
 	}
 	log.Printf("Created item: %+v\n", itemResp.Item)
 
+	// Create a customer to order for
+	createCustomerReq := &supplychain.CreateCustomerRequest{
+		Name:  "Laptop Store",
+		Email: "orders@laptopstore.example",
+		Addresses: []*supplychain.Address{
+			{Line1: "1 Market St", City: "San Francisco", Region: "CA", PostalCode: "94105", Country: "US"},
+		},
+	}
+	customerResp, err := client.CreateCustomer(ctx, createCustomerReq)
+	if err != nil {
+		log.Fatalf("Failed to create customer: %v", err)
+	}
+	log.Printf("Created customer: %+v\n", customerResp.Customer)
+
 	// Create an order
 	createOrderReq := &supplychain.CreateOrderRequest{
-		CustomerId: "CUST001",
+		CustomerId:      customerResp.Customer.Id,
+		ShipToAddressId: customerResp.Customer.Addresses[0].Id,
 		Items: []*supplychain.OrderItem{
 			{ItemId: itemResp.Item.Id, Quantity: 1},
 		},
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Scrimzay/supplychain/db"
+	"github.com/Scrimzay/supplychain/supplychain"
+)
+
+// apiKeyProto converts a stored key for a response
+func apiKeyProto(k *db.APIKey) *supplychain.ApiKey {
+	return &supplychain.ApiKey{
+		Id:          k.ID,
+		Prefix:      k.Prefix,
+		Role:        k.Role,
+		CustomerId:  k.CustomerID,
+		Description: k.Description,
+		CreatedAt:   k.CreatedAt,
+		ExpiresAt:   k.ExpiresAt,
+		RevokedAt:   k.RevokedAt,
+	}
+}
+
+// apiKeyError maps key store errors to a status
+func apiKeyError(err error, action string) error {
+	switch {
+	case errors.Is(err, db.ErrAPIKeyNotFound):
+		return status.Error(codes.NotFound, "API key not found")
+	case errors.Is(err, db.ErrRevokedAPIKey), errors.Is(err, db.ErrExpiredAPIKey):
+		return status.Errorf(codes.FailedPrecondition, "Cannot %s: %v", action, err)
+	}
+	return status.Errorf(codes.Internal, "Failed to %s", action)
+}
+
+func (s *SupplyChainServer) CreateApiKey(ctx context.Context, req *supplychain.CreateApiKeyRequest) (*supplychain.CreateApiKeyResponse, error) {
+	if !s.policy.Load().HasRole(req.Role) {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown role %q", req.Role)
+	}
+	switch {
+	case req.Role == "admin" && req.CustomerId != "":
+		return nil, status.Error(codes.InvalidArgument, "Admin keys don't belong to a customer")
+	case req.Role == "customer" && req.CustomerId == "":
+		return nil, status.Error(codes.InvalidArgument, "Customer keys need a customer ID")
+	}
+	// keys of any other role bound to a customer are limited to it
+	if req.CustomerId != "" {
+		var id string
+		err := s.db.QueryRowContext(ctx, "SELECT id FROM customers WHERE id = ?", req.CustomerId).Scan(&id)
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Customer not found")
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Failed to check customer")
+		}
+	}
+	if req.ExpiresAt != 0 && req.ExpiresAt <= time.Now().Unix() {
+		return nil, status.Error(codes.InvalidArgument, "Expiry is in the past")
+	}
+
+	k, key, err := s.db.CreateAPIKey(req.Role, req.CustomerId, req.Description, req.ExpiresAt)
+	if err != nil {
+		return nil, apiKeyError(err, "create API key")
+	}
+
+	return &supplychain.CreateApiKeyResponse{ApiKey: apiKeyProto(k), Key: key}, nil
+}
+
+func (s *SupplyChainServer) ListApiKeys(ctx context.Context, req *supplychain.ListApiKeysRequest) (*supplychain.ListApiKeysResponse, error) {
+	keys, err := s.db.ListAPIKeys(req.IncludeRevoked)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to list API keys")
+	}
+
+	resp := &supplychain.ListApiKeysResponse{}
+	for _, k := range keys {
+		resp.ApiKeys = append(resp.ApiKeys, apiKeyProto(k))
+	}
+
+	return resp, nil
+}
+
+func (s *SupplyChainServer) RevokeApiKey(ctx context.Context, req *supplychain.RevokeApiKeyRequest) (*supplychain.RevokeApiKeyResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "API key ID required")
+	}
+
+	k, err := s.db.RevokeAPIKey(req.Id)
+	if err != nil {
+		return nil, apiKeyError(err, "revoke API key")
+	}
+
+	return &supplychain.RevokeApiKeyResponse{ApiKey: apiKeyProto(k)}, nil
+}
+
+func (s *SupplyChainServer) RotateApiKey(ctx context.Context, req *supplychain.RotateApiKeyRequest) (*supplychain.RotateApiKeyResponse, error) {
+	if req.Id == "" || req.GraceSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid rotation details")
+	}
+
+	k, key, previous, err := s.db.RotateAPIKey(req.Id, req.GraceSeconds)
+	if err != nil {
+		return nil, apiKeyError(err, "rotate API key")
+	}
+
+	return &supplychain.RotateApiKeyResponse{ApiKey: apiKeyProto(k), Key: key, Previous: apiKeyProto(previous)}, nil
+}
@@ -0,0 +1,66 @@
+// Package auth works out who is calling an RPC from the request metadata.
+// Callers send either an API key in the api-key header or a JWT in the
+// authorization header as "Bearer <token>", or connect with a known client
+// certificate.
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Scrimzay/supplychain/db"
+)
+
+// ErrNoCredentials is returned by an authenticator when the request carries
+// none of the credentials it understands
+var ErrNoCredentials = errors.New("API key or bearer token required")
+
+// Identity is an authenticated caller
+type Identity struct {
+	// ID names the caller in audit logs, the key id for API keys,
+	// jwt:<subject> for tokens and cert:<common name> for certificates
+	ID         string
+	Role       string
+	CustomerID string // Customer the caller is limited to, if any
+}
+
+// Authenticator checks one kind of credential
+type Authenticator interface {
+	// Authenticate returns ErrNoCredentials when the request doesn't carry
+	// its kind of credential and any other error when it carries a bad one
+	Authenticate(ctx context.Context, md metadata.MD) (*Identity, error)
+}
+
+// Chain tries authenticators in order, the first one whose credentials the
+// request carries decides. A bad token never falls through to an API key.
+type Chain []Authenticator
+
+func (c Chain) Authenticate(ctx context.Context, md metadata.MD) (*Identity, error) {
+	for _, a := range c {
+		id, err := a.Authenticate(ctx, md)
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		}
+		return id, err
+	}
+	return nil, ErrNoCredentials
+}
+
+// APIKeys authenticates the api-key header against the stored keys
+type APIKeys struct {
+	Store *db.DatabaseStruct
+}
+
+func (a APIKeys) Authenticate(ctx context.Context, md metadata.MD) (*Identity, error) {
+	keys := md.Get("api-key")
+	if len(keys) == 0 {
+		return nil, ErrNoCredentials
+	}
+	key, err := a.Store.ValidateAPIKey(keys[0])
+	if err != nil {
+		return nil, err
+	}
+	return &Identity{ID: key.ID, Role: key.Role, CustomerID: key.CustomerID}, nil
+}
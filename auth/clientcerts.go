@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/Scrimzay/supplychain/policy"
+)
+
+// CertSubject is who a client certificate stands for
+type CertSubject struct {
+	CommonName string `json:"common_name"`
+	// Organization must also match when set
+	Organization string `json:"organization"`
+	Role         string `json:"role"`
+	CustomerID   string `json:"customer_id"`
+}
+
+// ClientCerts authenticates callers by the verified client certificate of
+// their connection. Certificates whose subject isn't listed only secure the
+// connection, the caller still needs an API key or token.
+type ClientCerts struct {
+	subjects map[string][]CertSubject // by common name
+}
+
+// LoadClientCerts reads the subjects client certificates map to, like
+//
+//	{"subjects": [
+//		{"common_name": "scanner-1", "organization": "Acme Warehouse", "role": "clerk"},
+//		{"common_name": "laptopstore-sync", "role": "customer", "customer_id": "LAPTOPSTORE001"}
+//	]}
+func LoadClientCerts(path string) (*ClientCerts, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file struct {
+		Subjects []CertSubject `json:"subjects"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	c := &ClientCerts{subjects: map[string][]CertSubject{}}
+	for _, s := range file.Subjects {
+		if s.CommonName == "" || !policy.ValidRoleName(s.Role) {
+			return nil, fmt.Errorf("%s: subject %q needs a common name and a role", path, s.CommonName)
+		}
+		if s.Role == "customer" && s.CustomerID == "" {
+			return nil, fmt.Errorf("%s: subject %q is a customer without a customer ID", path, s.CommonName)
+		}
+		c.subjects[s.CommonName] = append(c.subjects[s.CommonName], s)
+	}
+	return c, nil
+}
+
+func (c *ClientCerts) Authenticate(ctx context.Context, md metadata.MD) (*Identity, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, ErrNoCredentials
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil, ErrNoCredentials
+	}
+
+	subject := info.State.VerifiedChains[0][0].Subject
+	for _, s := range c.subjects[subject.CommonName] {
+		if s.Organization != "" && !slices.Contains(subject.Organization, s.Organization) {
+			continue
+		}
+		return &Identity{ID: "cert:" + subject.CommonName, Role: s.Role, CustomerID: s.CustomerID}, nil
+	}
+	return nil, ErrNoCredentials
+}
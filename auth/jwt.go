@@ -0,0 +1,142 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Scrimzay/supplychain/policy"
+)
+
+// signingMethods are the algorithms tokens may be signed with, never none
+// or HMAC since the keys come from a public JWKS
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// JWTConfig says which tokens are accepted and how their claims map to a
+// caller
+type JWTConfig struct {
+	Issuer   string // Required iss, unchecked when empty
+	Audience string // Required aud, unchecked when empty
+	// RoleClaim holds the caller's role, a string or a list of strings of
+	// which the first known role is used
+	RoleClaim string
+	// RoleMap renames roles from the token to roles of the policy, e.g. an
+	// identity provider group to a local role
+	RoleMap map[string]string
+	// CustomerClaim holds the customer the caller is limited to
+	CustomerClaim string
+	Leeway        time.Duration // Allowed clock skew for exp and nbf
+}
+
+// JWT authenticates bearer tokens signed by a key of a JWKS
+type JWT struct {
+	keys     *KeySet
+	config   JWTConfig
+	policies *policy.Holder
+	parser   *jwt.Parser
+}
+
+// NewJWT returns a bearer token authenticator, roles are checked against
+// the policy in force when a token is used
+func NewJWT(keys *KeySet, config JWTConfig, policies *policy.Holder) *JWT {
+	if config.RoleClaim == "" {
+		config.RoleClaim = "role"
+	}
+	if config.CustomerClaim == "" {
+		config.CustomerClaim = "customer_id"
+	}
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(config.Leeway),
+	}
+	if config.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(config.Issuer))
+	}
+	if config.Audience != "" {
+		opts = append(opts, jwt.WithAudience(config.Audience))
+	}
+	return &JWT{keys: keys, config: config, policies: policies, parser: jwt.NewParser(opts...)}
+}
+
+func (a *JWT) Authenticate(ctx context.Context, md metadata.MD) (*Identity, error) {
+	headers := md.Get("authorization")
+	if len(headers) == 0 {
+		return nil, ErrNoCredentials
+	}
+	scheme, raw, ok := strings.Cut(headers[0], " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return nil, ErrNoCredentials
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := a.parser.ParseWithClaims(strings.TrimSpace(raw), claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return a.keys.Key(kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid bearer token: %w", err)
+	}
+
+	subject, _ := claims.GetSubject()
+	if subject == "" {
+		return nil, errors.New("invalid bearer token: no subject")
+	}
+	role := a.role(claims[a.config.RoleClaim])
+	if role == "" {
+		return nil, errors.New("invalid bearer token: no known role")
+	}
+	customerID, _ := claims[a.config.CustomerClaim].(string)
+	if role == "customer" && customerID == "" {
+		return nil, errors.New("invalid bearer token: customer tokens need a customer")
+	}
+
+	return &Identity{ID: "jwt:" + subject, Role: role, CustomerID: customerID}, nil
+}
+
+// role picks the first role from the claim the policy knows about
+func (a *JWT) role(claim interface{}) string {
+	var names []string
+	switch v := claim.(type) {
+	case string:
+		names = strings.Fields(v)
+	case []interface{}:
+		for _, name := range v {
+			if s, ok := name.(string); ok {
+				names = append(names, s)
+			}
+		}
+	}
+	p := a.policies.Load()
+	for _, name := range names {
+		if mapped, ok := a.config.RoleMap[name]; ok {
+			name = mapped
+		}
+		if p.HasRole(name) {
+			return name
+		}
+	}
+	return ""
+}
+
+// ParseRoleMap reads a role map written token=local,token=local
+func ParseRoleMap(s string) (map[string]string, error) {
+	roles := map[string]string{}
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		from, to, ok := strings.Cut(pair, "=")
+		from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+		if !ok || from == "" || !policy.ValidRoleName(to) {
+			return nil, fmt.Errorf("invalid role mapping %q", pair)
+		}
+		roles[from] = to
+	}
+	return roles, nil
+}
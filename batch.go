@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Scrimzay/supplychain/supplychain"
+)
+
+// maxBatch is the most entries a batch call takes
+const maxBatch = 500
+
+// errNotApplied is the result of an entry that worked in an all-or-nothing
+// batch where another entry didn't
+var errNotApplied = status.Error(codes.Aborted, "Not applied, another entry in the batch failed")
+
+// runBatch applies every entry of a batch in one transaction, each in a
+// savepoint so a failed entry leaves nothing behind. In all-or-nothing mode
+// a single failure rolls back the whole batch, in best-effort mode the
+// entries that worked are committed. It returns the result of every entry.
+func (s *SupplyChainServer) runBatch(ctx context.Context, mode supplychain.BatchMode, n int, apply func(tx *sql.Tx, i int) error) ([]error, error) {
+	if n == 0 || n > maxBatch {
+		return nil, status.Errorf(codes.InvalidArgument, "A batch takes 1 to %d entries", maxBatch)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	results := make([]error, n)
+	failed := false
+	for i := range results {
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT batch_entry"); err != nil {
+			return nil, status.Error(codes.Internal, "Failed to start batch entry")
+		}
+		if results[i] = apply(tx, i); results[i] != nil {
+			failed = true
+			if _, err := tx.ExecContext(ctx, "ROLLBACK TO batch_entry"); err != nil {
+				return nil, status.Error(codes.Internal, "Failed to roll back batch entry")
+			}
+		}
+		if _, err := tx.ExecContext(ctx, "RELEASE batch_entry"); err != nil {
+			return nil, status.Error(codes.Internal, "Failed to finish batch entry")
+		}
+	}
+
+	if failed && mode != supplychain.BatchMode_BATCH_MODE_BEST_EFFORT {
+		for i := range results {
+			if results[i] == nil {
+				results[i] = errNotApplied
+			}
+		}
+		return results, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to commit transaction")
+	}
+	return results, nil
+}
+
+// entryStatus is the code and message a batch result reports for an entry
+func entryStatus(err error) (code, msg string) {
+	st := status.Convert(err)
+	return st.Code().String(), st.Message()
+}
+
+// countResults returns how many entries worked and how many didn't
+func countResults(results []error) (succeeded, failed int32) {
+	for _, err := range results {
+		if err == nil {
+			succeeded++
+		} else {
+			failed++
+		}
+	}
+	return succeeded, failed
+}
+
+func (s *SupplyChainServer) BatchUpdateItems(ctx context.Context, req *supplychain.BatchUpdateItemsRequest) (*supplychain.BatchUpdateItemsResponse, error) {
+	items := make([]*supplychain.Item, len(req.Items))
+	results, err := s.runBatch(ctx, req.Mode, len(req.Items), func(tx *sql.Tx, i int) error {
+		item, found, err := updateItem(ctx, tx, req.Items[i])
+		if err != nil {
+			return err
+		}
+		if !found {
+			return status.Error(codes.NotFound, "Item not found")
+		}
+		items[i] = item
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &supplychain.BatchUpdateItemsResponse{}
+	resp.Succeeded, resp.Failed = countResults(results)
+	for i, entry := range req.Items {
+		result := &supplychain.BatchItemResult{Id: entry.Id}
+		result.Code, result.Error = entryStatus(results[i])
+		if results[i] == nil {
+			result.Item = items[i]
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	if resp.Succeeded > 0 {
+		s.inventory.notify()
+	}
+
+	return resp, nil
+}
+
+func (s *SupplyChainServer) BatchGetOrders(ctx context.Context, req *supplychain.BatchGetOrdersRequest) (*supplychain.BatchGetOrdersResponse, error) {
+	orders := make([]*supplychain.Order, len(req.Ids))
+	histories := make([][]*supplychain.OrderEvent, len(req.Ids))
+	// reading in the one transaction gives every order as of the same moment
+	results, err := s.runBatch(ctx, req.Mode, len(req.Ids), func(tx *sql.Tx, i int) error {
+		order, history, err := getOrder(ctx, tx, req.Ids[i])
+		if err != nil {
+			return err
+		}
+		orders[i], histories[i] = order, history
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &supplychain.BatchGetOrdersResponse{}
+	resp.Succeeded, resp.Failed = countResults(results)
+	for i, id := range req.Ids {
+		result := &supplychain.BatchOrderResult{Id: id}
+		result.Code, result.Error = entryStatus(results[i])
+		if results[i] == nil {
+			result.Order, result.History = orders[i], histories[i]
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	return resp, nil
+}
+
+func (s *SupplyChainServer) BatchUpdateShipments(ctx context.Context, req *supplychain.BatchUpdateShipmentsRequest) (*supplychain.BatchUpdateShipmentsResponse, error) {
+	shipments := make([]*supplychain.Shipment, len(req.Shipments))
+	results, err := s.runBatch(ctx, req.Mode, len(req.Shipments), func(tx *sql.Tx, i int) error {
+		shipment, err := updateShipment(ctx, tx, req.Shipments[i])
+		if err != nil {
+			return err
+		}
+		shipments[i] = shipment
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &supplychain.BatchUpdateShipmentsResponse{}
+	resp.Succeeded, resp.Failed = countResults(results)
+	for i, entry := range req.Shipments {
+		result := &supplychain.BatchShipmentResult{Id: entry.Id}
+		result.Code, result.Error = entryStatus(results[i])
+		if results[i] == nil {
+			result.Shipment = shipments[i]
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	if resp.Succeeded > 0 {
+		s.orderUpdates.notify()
+	}
+
+	return resp, nil
+}
+
+// batchAudit is what the audit log keeps of a batch call, a summary instead
+// of every entry
+type batchAudit struct {
+	Mode      string   `json:"mode"`
+	Entries   int      `json:"entries"`
+	Succeeded int32    `json:"succeeded"`
+	Failed    int32    `json:"failed"`
+	IDs       []string `json:"ids"`
+}
+
+// auditRecord is what the audit log keeps of a call's request, batches are
+// summed up with the outcome from resp, which may be nil
+func auditRecord(req, resp interface{}) interface{} {
+	switch r := req.(type) {
+	case *supplychain.BatchUpdateItemsRequest:
+		out, _ := resp.(*supplychain.BatchUpdateItemsResponse)
+		record := batchAudit{Mode: r.Mode.String(), Entries: len(r.Items), Succeeded: out.GetSucceeded(), Failed: out.GetFailed()}
+		for _, item := range r.Items {
+			record.IDs = append(record.IDs, item.Id)
+		}
+		return record
+	case *supplychain.BatchGetOrdersRequest:
+		out, _ := resp.(*supplychain.BatchGetOrdersResponse)
+		return batchAudit{Mode: r.Mode.String(), Entries: len(r.Ids), Succeeded: out.GetSucceeded(), Failed: out.GetFailed(), IDs: r.Ids}
+	case *supplychain.BatchUpdateShipmentsRequest:
+		out, _ := resp.(*supplychain.BatchUpdateShipmentsResponse)
+		record := batchAudit{Mode: r.Mode.String(), Entries: len(r.Shipments), Succeeded: out.GetSucceeded(), Failed: out.GetFailed()}
+		for _, shipment := range r.Shipments {
+			record.IDs = append(record.IDs, shipment.Id)
+		}
+		return record
+	}
+	return req
+}
@@ -0,0 +1,211 @@
+// Package certs builds the TLS settings of the server and its clients. The
+// server's certificate and the CA it checks client certificates against are
+// read again whenever their files change, so they can be rotated in place.
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// ServerOptions are the files the server's TLS settings come from
+type ServerOptions struct {
+	CertFile string
+	KeyFile  string
+	// ClientCAFile turns on client certificate checks, certificates must
+	// be signed by one of its CAs
+	ClientCAFile string
+	// RequireClientCert refuses connections without a client certificate,
+	// otherwise one is only checked when given
+	RequireClientCert bool
+}
+
+// Reloader holds the server's certificate and client CAs, reloading them
+// when their files change
+type Reloader struct {
+	opts ServerOptions
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	clientCA *x509.CertPool
+	modTimes map[string]time.Time
+}
+
+// NewReloader loads the server's certificate and client CAs
+func NewReloader(opts ServerOptions) (*Reloader, error) {
+	if opts.CertFile == "" || opts.KeyFile == "" {
+		return nil, errors.New("certificate and key files are both required")
+	}
+	if opts.RequireClientCert && opts.ClientCAFile == "" {
+		return nil, errors.New("requiring client certificates needs a client CA file")
+	}
+	r := &Reloader{opts: opts}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Reloader) files() []string {
+	files := []string{r.opts.CertFile, r.opts.KeyFile}
+	if r.opts.ClientCAFile != "" {
+		files = append(files, r.opts.ClientCAFile)
+	}
+	return files
+}
+
+func (r *Reloader) load() error {
+	modTimes := map[string]time.Time{}
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return err
+		}
+		modTimes[file] = info.ModTime()
+	}
+	cert, pool, err := r.read()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// a failed load is only retried once the files change again
+	r.modTimes = modTimes
+	if err != nil {
+		return err
+	}
+	r.cert = cert
+	r.clientCA = pool
+	return nil
+}
+
+func (r *Reloader) read() (*tls.Certificate, *x509.CertPool, error) {
+	cert, err := tls.LoadX509KeyPair(r.opts.CertFile, r.opts.KeyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	var pool *x509.CertPool
+	if r.opts.ClientCAFile != "" {
+		if pool, err = loadPool(r.opts.ClientCAFile); err != nil {
+			return nil, nil, err
+		}
+	}
+	return &cert, pool, nil
+}
+
+// changed reports whether any of the files was modified since the last load
+func (r *Reloader) changed() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			continue
+		}
+		if !info.ModTime().Equal(r.modTimes[file]) {
+			return true
+		}
+	}
+	return false
+}
+
+// Watch reloads the files whenever they change. Files that don't load, like
+// a certificate written before its key, leave the current ones in use.
+func (r *Reloader) Watch(interval time.Duration) {
+	for range time.Tick(interval) {
+		if !r.changed() {
+			continue
+		}
+		if err := r.load(); err != nil {
+			log.Printf("Failed to reload TLS certificates, keeping the current ones: %v", err)
+			continue
+		}
+		log.Printf("Reloaded TLS certificates from %s", r.opts.CertFile)
+	}
+}
+
+// Config returns TLS settings that always use the latest certificates
+func (r *Reloader) Config() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+				NextProtos:   []string{"h2"},
+			}
+			if r.clientCA != nil {
+				config.ClientCAs = r.clientCA
+				config.ClientAuth = tls.VerifyClientCertIfGiven
+				if r.opts.RequireClientCert {
+					config.ClientAuth = tls.RequireAndVerifyClientCert
+				}
+			}
+			return config, nil
+		},
+	}
+}
+
+// ClientOptions are the files a client's TLS settings come from
+type ClientOptions struct {
+	CAFile     string // CA the server certificate is checked against, the system's when empty
+	CertFile   string // Client certificate, for servers checking them
+	KeyFile    string
+	ServerName string // Name expected in the server certificate, the host dialed when empty
+	// TLS connects with TLS even when no files are given
+	TLS bool
+}
+
+// enabled reports whether the options ask for TLS
+func (o ClientOptions) enabled() bool {
+	return o.TLS || o.CAFile != "" || o.CertFile != "" || o.KeyFile != ""
+}
+
+// DialOption returns the transport credentials for the options, plaintext
+// when none of them are set
+func DialOption(opts ClientOptions) (grpc.DialOption, error) {
+	if !opts.enabled() {
+		return grpc.WithTransportCredentials(insecure.NewCredentials()), nil
+	}
+	config := &tls.Config{MinVersion: tls.VersionTLS12, ServerName: opts.ServerName}
+	if opts.CAFile != "" {
+		pool, err := loadPool(opts.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = pool
+	}
+	if (opts.CertFile == "") != (opts.KeyFile == "") {
+		return nil, errors.New("client certificate and key files are both required")
+	}
+	if opts.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(config)), nil
+}
+
+func loadPool(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("%s: no certificates found", path)
+	}
+	return pool, nil
+}
@@ -1,23 +1,297 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"encoding/csv"
 	"flag"
 	"fmt"
+	"io"
 	"log"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
 
+	"github.com/Scrimzay/supplychain/certs"
+	"github.com/Scrimzay/supplychain/money"
 	"github.com/Scrimzay/supplychain/supplychain"
 )
 
+// fatalWithDetails prints any stock shortfalls carried by err before exiting
+func fatalWithDetails(msg string, err error) {
+	if st, ok := grpcstatus.FromError(err); ok {
+		for _, detail := range st.Details() {
+			if shortage, ok := detail.(*supplychain.InsufficientStock); ok {
+				for _, line := range shortage.Lines {
+					fmt.Printf("  Short: Item %s, Requested: %d, Available: %d\n", line.ItemId, line.Requested, line.Available)
+				}
+			}
+		}
+	}
+	log.Fatalf("%s: %v", msg, err)
+}
+
+// parseLines reads "item:quantity,item:quantity" into order lines
+func parseLines(s string) ([]*supplychain.OrderItem, error) {
+	var lines []*supplychain.OrderItem
+	for _, part := range strings.Split(s, ",") {
+		itemID, qty, ok := strings.Cut(strings.TrimSpace(part), ":")
+		if !ok {
+			return nil, fmt.Errorf("expected item:quantity, got %q", part)
+		}
+		n, err := strconv.Atoi(qty)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quantity in %q", part)
+		}
+		lines = append(lines, &supplychain.OrderItem{ItemId: itemID, Quantity: int32(n)})
+	}
+	return lines, nil
+}
+
+// parseItemsCSV reads id,name,description,quantity,price,currency[,tax_category]
+// rows for an import, with the line each row came from. A header row is
+// skipped and an empty id makes a new item.
+func parseItemsCSV(data []byte) ([]*supplychain.ImportItemsRequest, []int, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	r.Comment = '#'
+
+	var rows []*supplychain.ImportItemsRequest
+	var lines []int
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		line, _ := r.FieldPos(0)
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "id") {
+			continue
+		}
+		if len(record) != 6 && len(record) != 7 {
+			return nil, nil, fmt.Errorf("line %d: expected 6 or 7 columns, got %d", line, len(record))
+		}
+		quantity, err := strconv.ParseInt(strings.TrimSpace(record[3]), 10, 32)
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: invalid quantity %q", line, record[3])
+		}
+		price, err := strconv.ParseFloat(strings.TrimSpace(record[4]), 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: invalid price %q", line, record[4])
+		}
+		currency := strings.ToUpper(strings.TrimSpace(record[5]))
+		value, err := money.ToMinor(price, currency)
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		row := &supplychain.ImportItemsRequest{
+			Id:          strings.TrimSpace(record[0]),
+			Name:        strings.TrimSpace(record[1]),
+			Description: strings.TrimSpace(record[2]),
+			Quantity:    int32(quantity),
+			UnitPrice:   &supplychain.Amount{Value: value, Currency: currency},
+		}
+		if len(record) == 7 {
+			row.TaxCategory = strings.TrimSpace(record[6])
+		}
+		rows = append(rows, row)
+		lines = append(lines, line)
+	}
+	return rows, lines, nil
+}
+
+// parseShipmentsCSV reads id,status[,tracking_number[,location[,note]]] rows
+// for -batchupdateshipments, a header row is skipped
+func parseShipmentsCSV(data []byte) ([]*supplychain.UpdateShipmentRequest, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	r.Comment = '#'
+
+	var updates []*supplychain.UpdateShipmentRequest
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := r.FieldPos(0)
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "id") {
+			continue
+		}
+		if len(record) < 2 || len(record) > 5 {
+			return nil, fmt.Errorf("line %d: expected 2 to 5 columns, got %d", line, len(record))
+		}
+		for len(record) < 5 {
+			record = append(record, "")
+		}
+		updates = append(updates, &supplychain.UpdateShipmentRequest{
+			Id:             strings.TrimSpace(record[0]),
+			Status:         strings.ToUpper(strings.TrimSpace(record[1])),
+			TrackingNumber: strings.TrimSpace(record[2]),
+			Location:       strings.TrimSpace(record[3]),
+			Note:           strings.TrimSpace(record[4]),
+		})
+	}
+	return updates, nil
+}
+
+// batchMode picks the mode of a batch call from -besteffort
+func batchMode(bestEffort bool) supplychain.BatchMode {
+	if bestEffort {
+		return supplychain.BatchMode_BATCH_MODE_BEST_EFFORT
+	}
+	return supplychain.BatchMode_BATCH_MODE_ALL_OR_NOTHING
+}
+
+// batchResult is what the results of every batch call have
+type batchResult interface {
+	GetId() string
+	GetCode() string
+	GetError() string
+}
+
+// printBatch prints the totals of a batch call and every entry that failed
+func printBatch[R batchResult](action string, succeeded, failed int32, results []R) {
+	fmt.Printf("%s: %d succeeded, %d failed\n", action, succeeded, failed)
+	for _, r := range results {
+		if r.GetCode() != "OK" {
+			fmt.Printf("  %s: %s %s\n", r.GetId(), r.GetCode(), r.GetError())
+		}
+	}
+}
+
+// parseScan reads a line typed or scanned into -picksession, "order {id}"
+// starts an order and "{item id} [quantity]" picks an item
+func parseScan(line string) (*supplychain.PickRequest, error) {
+	fields := strings.Fields(line)
+	switch {
+	case len(fields) == 2 && strings.EqualFold(fields[0], "order"):
+		return &supplychain.PickRequest{OrderId: fields[1]}, nil
+	case len(fields) == 1:
+		return &supplychain.PickRequest{ItemId: fields[0]}, nil
+	case len(fields) == 2:
+		n, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid quantity %q", fields[1])
+		}
+		return &supplychain.PickRequest{ItemId: fields[0], Quantity: int32(n)}, nil
+	}
+	return nil, fmt.Errorf("expected \"order {id}\" or \"{item id} [quantity]\", got %q", line)
+}
+
+// printPick prints the answer to a scan and the progress of the pick
+func printPick(resp *supplychain.PickResponse) {
+	fmt.Printf("%s: %s\n", strings.TrimPrefix(resp.Status.String(), "PICK_STATUS_"), resp.Message)
+	for _, line := range resp.Lines {
+		fmt.Printf("  %s (ID: %s): %d/%d\n", line.ItemName, line.ItemId, line.Picked, line.Quantity)
+	}
+	for _, short := range resp.Shortfalls {
+		fmt.Printf("  Short: %s, Requested: %d, Available: %d\n", short.ItemId, short.Requested, short.Available)
+	}
+	if resp.Order != nil {
+		fmt.Printf("  Order %s is %s\n", resp.Order.Id, resp.Order.Status)
+	}
+}
+
+// printReturn prints a return and its lines
+func printReturn(action string, ret *supplychain.Return) {
+	fmt.Printf("%s return: %s, Order: %s, Status: %s, Refund: %s %s\n",
+		action, ret.Id, ret.OrderId, ret.Status, ret.RefundTotal.DisplayValue, ret.RefundTotal.Currency)
+	for _, item := range ret.Items {
+		fmt.Printf("  Item: %s, Quantity: %d, Reason: %s, Refund: %s", item.ItemId, item.Quantity, item.ReasonCode, item.Refund.DisplayValue)
+		if item.Condition != "" {
+			fmt.Printf(", Condition: %s, %s to %s", item.Condition, item.Disposition, item.Location)
+		}
+		fmt.Println()
+	}
+}
+
+// printPromotion prints a promotion on one line
+func printPromotion(action string, p *supplychain.Promotion) {
+	code := p.Code
+	if code == "" {
+		code = "(automatic)"
+	}
+	fmt.Printf("%s: %s (ID: %s), Type: %s, Code: %s, Active: %v, Used: %d", action, p.Name, p.Id, p.Type, code, p.Active, p.UsageCount)
+	if p.UsageLimit > 0 {
+		fmt.Printf("/%d", p.UsageLimit)
+	}
+	fmt.Println()
+}
+
+// printAPIKey prints an API key on one line, without the key itself
+func printAPIKey(action string, k *supplychain.ApiKey) {
+	fmt.Printf("%s: %s... (ID: %s), Role: %s", action, k.Prefix, k.Id, k.Role)
+	if k.CustomerId != "" {
+		fmt.Printf(", Customer: %s", k.CustomerId)
+	}
+	if k.ExpiresAt != 0 {
+		fmt.Printf(", Expires: %s", time.Unix(k.ExpiresAt, 0).Format(time.RFC3339))
+	}
+	if k.RevokedAt != 0 {
+		fmt.Printf(", Revoked: %s", time.Unix(k.RevokedAt, 0).Format(time.RFC3339))
+	}
+	if k.Description != "" {
+		fmt.Printf(", %s", k.Description)
+	}
+	fmt.Println()
+}
+
+// printCustomer prints a customer and its addresses
+func printCustomer(action string, c *supplychain.Customer) {
+	fmt.Printf("%s: %s (ID: %s), Email: %s, Phone: %s\n", action, c.Name, c.Id, c.Email, c.Phone)
+	for _, a := range c.Addresses {
+		fmt.Printf("  Address %s (%s): %s\n", a.Id, strings.ToLower(strings.TrimPrefix(a.Type.String(), "ADDRESS_TYPE_")), formatAddress(a))
+	}
+}
+
+// formatAddress writes an address on one line
+func formatAddress(a *supplychain.Address) string {
+	var parts []string
+	for _, part := range []string{a.Name, a.Line1, a.Line2, a.City, strings.TrimSpace(a.Region + " " + a.PostalCode), a.Country} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// retryAfter prints how long the server wants us to wait when a call is
+// over a rate limit or quota
+func retryAfter(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	var header metadata.MD
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...)
+	if grpcstatus.Code(err) == codes.ResourceExhausted {
+		if wait := header.Get("retry-after"); len(wait) > 0 {
+			fmt.Printf("Rate limited, retry after %ss\n", wait[0])
+		}
+	}
+	return err
+}
+
 func main() {
 	// Define global flags
 	connectAddr := flag.String("connect", "localhost:8089", "gRPC server address")
+	useTLS := flag.Bool("tls", false, "Connect with TLS, implied by -cacert and -tlscert")
+	caCert := flag.String("cacert", "", "CA file to check the server certificate against, the system CAs without one")
+	tlsCert := flag.String("tlscert", "", "Client certificate file, for servers checking them")
+	tlsKey := flag.String("tlskey", "", "Client certificate key file")
+	serverName := flag.String("servername", "", "Name expected in the server certificate if not the -connect host")
 	apiKey := flag.String("apikey", "", "API key for authentication")
+	token := flag.String("token", "", "Bearer token (JWT) for authentication, instead of -apikey")
 
 	// Define command flags
 	createItem := flag.Bool("createitem", false, "Create a new item")
@@ -26,44 +300,168 @@ func main() {
 	createOrder := flag.Bool("createorder", false, "Create a new order")
 	fulfillOrder := flag.Bool("fulfillorder", false, "Fulfill an order")
 	getOrder := flag.Bool("getorder", false, "Get order details")
+	cancelOrder := flag.Bool("cancelorder", false, "Cancel an order")
+	listOrders := flag.Bool("listorders", false, "List orders")
 	createShipment := flag.Bool("createshipment", false, "Create a shipment")
 	updateShipment := flag.Bool("updateshipment", false, "Update a shipment")
+	getShipment := flag.Bool("getshipment", false, "Get a shipment and its tracking history")
+	watchOrder := flag.Bool("watchorder", false, "Print an order's history and then its updates as they happen, until it is delivered or cancelled")
+	watchShipment := flag.Bool("watchshipment", false, "Print a shipment's tracking history and then new scans, until it is delivered or returned")
+	pickSession := flag.Bool("picksession", false, "Pick orders by scanning, reads \"order {id}\" and \"{item id} [quantity]\" lines from stdin")
+	afterEvent := flag.Int64("afterevent", 0, "Skip the history up to this event ID for -watchorder and -watchshipment")
 	listItems := flag.Bool("listitems", false, "List items")
+	watchInventory := flag.Bool("watchinventory", false, "Print item and stock changes as they happen (-items, -namefilter, -resume)")
+	importItems := flag.Bool("importitems", false, "Create or update items from a CSV file of id,name,description,quantity,price,currency[,tax_category] rows (-file, -dryrun)")
+	dryRun := flag.Bool("dryrun", false, "Check an -importitems file without writing anything")
+	batchUpdateItems := flag.Bool("batchupdateitems", false, "Update items from a CSV file of id,name,description,quantity,price,currency[,tax_category] rows in one call (-file, -besteffort)")
+	batchGetOrders := flag.Bool("batchgetorders", false, "Get several orders in one call (-ids, -besteffort)")
+	batchUpdateShipments := flag.Bool("batchupdateshipments", false, "Update shipments from a CSV file of id,status[,tracking,location,note] rows in one call (-file, -besteffort)")
+	bestEffort := flag.Bool("besteffort", false, "Keep the entries of a batch that work instead of failing the whole batch")
+	ids := flag.String("ids", "", "Order IDs for -batchgetorders, comma separated")
 	listShipments := flag.Bool("listshipments", false, "List shipments")
+	createReturn := flag.Bool("createreturn", false, "Request a return for a fulfilled or delivered order")
+	approveReturn := flag.Bool("approvereturn", false, "Approve (or with -reject, reject) a return")
+	receiveReturn := flag.Bool("receivereturn", false, "Receive the goods of an approved return")
+	getReturn := flag.Bool("getreturn", false, "Get return details")
+	addRate := flag.Bool("addrate", false, "Add an exchange rate")
+	importRates := flag.Bool("importrates", false, "Import exchange rates from a CSV file")
+	listRates := flag.Bool("listrates", false, "List exchange rates")
+	createPromo := flag.Bool("createpromo", false, "Create a promotion")
+	listPromos := flag.Bool("listpromos", false, "List promotions")
+	deactivatePromo := flag.Bool("deactivatepromo", false, "Deactivate a promotion")
+	createCustomer := flag.Bool("createcustomer", false, "Create a customer, with an address if -line1 is given")
+	getCustomer := flag.Bool("getcustomer", false, "Get a customer and its addresses")
+	updateCustomer := flag.Bool("updatecustomer", false, "Update a customer's contact details")
+	deleteCustomer := flag.Bool("deletecustomer", false, "Delete a customer without orders")
+	listCustomers := flag.Bool("listcustomers", false, "List customers")
+	addAddress := flag.Bool("addaddress", false, "Add an address to a customer")
+	removeAddress := flag.Bool("removeaddress", false, "Remove an address from a customer")
+	createKey := flag.Bool("createkey", false, "Create an API key")
+	listKeys := flag.Bool("listkeys", false, "List API keys")
+	revokeKey := flag.Bool("revokekey", false, "Revoke an API key")
+	rotateKey := flag.Bool("rotatekey", false, "Replace an API key with a new one")
+	listRoles := flag.Bool("listroles", false, "List roles and their permissions")
+	putRole := flag.Bool("putrole", false, "Create or replace a role")
+	deleteRole := flag.Bool("deleterole", false, "Delete a role")
+	listPerms := flag.Bool("listperms", false, "List permissions and the RPCs they allow")
 	audit := flag.Bool("audit", false, "View audit logs for an API key")
 
 	// Define argument flags
-	name := flag.String("name", "", "Item name")
+	name := flag.String("name", "", "Item, customer, promotion or role name")
 	description := flag.String("description", "", "Item description")
 	quantity := flag.Int("quantity", 0, "Item or order quantity")
-	price := flag.Float64("price", 0, "Item price in dollars (e.g., 1000.00)")
+	price := flag.Float64("price", 0, "Item price in major units of -currency (e.g., 1000.00)")
 	currency := flag.String("currency", "USD", "Currency (e.g., USD)")
-	id := flag.String("id", "", "Item or shipment ID")
-	customer := flag.String("customer", "", "Customer ID for order")
+	id := flag.String("id", "", "Item, shipment, promotion or API key ID")
+	customer := flag.String("customer", "", "Customer ID for order or customer commands")
 	itemID := flag.String("item", "", "Item ID for order")
 	orderID := flag.String("order", "", "Order ID")
 	trackingNumber := flag.String("tracking", "", "Shipment tracking number")
-	status := flag.String("status", "", "Shipment status")
+	status := flag.String("status", "", "Shipment or order status")
 	nameFilter := flag.String("namefilter", "", "Filter for listing items")
-	auditKey := flag.String("auditkey", "", "API key to audit")
+	auditKey := flag.String("auditkey", "", "ID of the API key to audit (see -listkeys), or jwt:<subject> for a token")
+	reason := flag.String("reason", "", "Reason for cancelling an order or returning goods")
+	partial := flag.Bool("partial", false, "Fulfill what is on hand and backorder the rest")
+	after := flag.String("after", "", "List orders created at or after this RFC3339 time")
+	before := flag.String("before", "", "List orders created before this RFC3339 time")
+	sortBy := flag.String("sort", "created", "Sort orders by created or total")
+	desc := flag.Bool("desc", false, "Sort in descending order")
+	pageToken := flag.String("pagetoken", "", "Page token from a previous -listorders")
+	location := flag.String("location", "", "Shipment location for a tracking update")
+	note := flag.String("note", "", "Note for a tracking update")
+	lines := flag.String("lines", "", "Shipment or return contents as item:quantity,item:quantity (default for shipments: everything ready to ship)")
+	reasonCode := flag.String("reasoncode", "OTHER", "Return reason code for every line")
+	reject := flag.Bool("reject", false, "Reject rather than approve a return")
+	condition := flag.String("condition", "", "Condition returned goods arrived in (NEW, OPENED, DAMAGED, DEFECTIVE)")
 	page := flag.Int("page", 1, "Page number for listing or audit")
 	pageSize := flag.Int("pagesize", 10, "Page size for listing or audit")
+	settle := flag.String("settle", "", "Settlement currency to convert an order into")
+	region := flag.String("region", "", "State or province of an address (e.g., CA)")
+	taxCategory := flag.String("taxcategory", "", "Item tax category (default STANDARD)")
+	base := flag.String("base", "", "Base currency of an exchange rate")
+	quote := flag.String("quote", "", "Quote currency of an exchange rate")
+	rate := flag.String("rate", "", "Exchange rate, units of -quote per unit of -base (e.g., 0.92)")
+	effective := flag.String("effective", "", "RFC3339 time an exchange rate takes effect (default now)")
+	asOf := flag.String("asof", "", "Only list the rates in effect at this RFC3339 time")
+	file := flag.String("file", "", "CSV file for -importrates (base,quote,rate,effective_at rows), -importitems, -batchupdateitems or -batchupdateshipments")
+	coupons := flag.String("coupons", "", "Coupon codes for an order, comma separated")
+	promoType := flag.String("type", "percentage", "Promotion type: percentage, fixed, bxgy or tiered")
+	code := flag.String("code", "", "Coupon code of a promotion (empty applies it automatically)")
+	items := flag.String("items", "", "Item IDs a promotion is limited to or -watchinventory watches, comma separated")
+	resumeToken := flag.String("resume", "", "Resume token of the last event seen, to replay what was missed")
+	percent := flag.String("percent", "", "Percent off for percentage promotions (e.g., 12.5)")
+	buy := flag.Int("buy", 0, "Units to buy for bxgy promotions")
+	get := flag.Int("get", 0, "Units free for bxgy promotions")
+	tiers := flag.String("tiers", "", "Tiers for tiered promotions as minquantity:percent,minquantity:percent")
+	starts := flag.String("starts", "", "RFC3339 time a promotion starts")
+	ends := flag.String("ends", "", "RFC3339 time a promotion ends")
+	limit := flag.Int("limit", 0, "Orders a promotion can be used on (0 for no limit)")
+	all := flag.Bool("all", false, "Include inactive promotions or revoked API keys")
+	role := flag.String("role", "", "Role of a new API key (e.g., admin, customer or a role from -listroles)")
+	expires := flag.String("expires", "", "RFC3339 time a new API key expires")
+	permissions := flag.String("permissions", "", "Permissions of a role, comma separated (e.g., orders:read,shipments:*)")
+	grace := flag.Duration("grace", 0, "How long a rotated API key keeps working (e.g., 24h)")
+	email := flag.String("email", "", "Customer email")
+	phone := flag.String("phone", "", "Customer phone number")
+	addressType := flag.String("addresstype", "shipping", "Address type: shipping or billing")
+	recipient := flag.String("recipient", "", "Recipient name of an address")
+	line1 := flag.String("line1", "", "First address line")
+	line2 := flag.String("line2", "", "Second address line")
+	city := flag.String("city", "", "City of an address")
+	postal := flag.String("postal", "", "Postal code of an address")
+	country := flag.String("country", "", "Country of an address (e.g., US)")
+	addressID := flag.String("address", "", "Address ID (the ship-to address for -createorder)")
 
 	flag.Parse()
 
-	if *apiKey == "" {
-		log.Fatal("API key is required (-apiKey)")
+	if *apiKey == "" && *token == "" && *tlsCert == "" {
+		log.Fatal("API key, token or client certificate is required (-apikey, -token or -tlscert)")
+	}
+
+	address := func() *supplychain.Address {
+		a := &supplychain.Address{
+			Name:       *recipient,
+			Line1:      *line1,
+			Line2:      *line2,
+			City:       *city,
+			Region:     *region,
+			PostalCode: *postal,
+			Country:    *country,
+		}
+		switch *addressType {
+		case "shipping":
+		case "billing":
+			a.Type = supplychain.AddressType_ADDRESS_TYPE_BILLING
+		default:
+			log.Fatal("-addresstype must be shipping or billing")
+		}
+		return a
 	}
 
 	// Connect to gRPC server
-	conn, err := grpc.Dial(*connectAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	transport, err := certs.DialOption(certs.ClientOptions{
+		CAFile:     *caCert,
+		CertFile:   *tlsCert,
+		KeyFile:    *tlsKey,
+		ServerName: *serverName,
+		TLS:        *useTLS,
+	})
+	if err != nil {
+		log.Fatalf("Failed to load TLS settings: %v", err)
+	}
+	conn, err := grpc.Dial(*connectAddr, transport, grpc.WithUnaryInterceptor(retryAfter))
 	if err != nil {
 		log.Fatalf("Failed to connect to %s: %v", *connectAddr, err)
 	}
 	defer conn.Close()
 
 	client := supplychain.NewSupplyChainClient(conn)
-	ctx := metadata.AppendToOutgoingContext(context.Background(), "api-key", *apiKey)
+	ctx := context.Background()
+	if *token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+*token)
+	} else if *apiKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "api-key", *apiKey)
+	}
 
 	// handle commands
 	switch {
@@ -71,43 +469,53 @@ func main() {
 		if *name == "" || *quantity <= 0 || *price <= 0 {
 			log.Fatal("Required flags for -createitem: -name, -quantity, -price")
 		}
+		unitPrice, err := money.ToMinor(*price, *currency)
+		if err != nil {
+			log.Fatalf("Invalid -currency %q: %v", *currency, err)
+		}
 		req := &supplychain.CreateItemRequest{
 			Name: *name,
 			Description: *description,
 			Quantity: int32(*quantity),
 			UnitPrice: &supplychain.Amount{
-				Value: int64(*price * 100),
+				Value: unitPrice,
 				Currency: *currency,
 			},
+			TaxCategory: *taxCategory,
 		}
 		resp, err := client.CreateItem(ctx, req)
 		if err != nil {
 			log.Fatalf("Failed to create item: %v", err)
 		}
-		fmt.Printf("Created item: %s (ID: %s), Quantity: %d, Unit Price: %s %s\n",
-			resp.Item.Name, resp.Item.Id, resp.Item.Quantity,
+		fmt.Printf("Created item: %s (ID: %s), Quantity: %d (Reserved: %d, Available: %d), Unit Price: %s %s\n",
+			resp.Item.Name, resp.Item.Id, resp.Item.Quantity, resp.Item.ReservedQuantity, resp.Item.AvailableQuantity,
 			resp.Item.UnitPrice.DisplayValue, resp.Item.UnitPrice.Currency)
 
 	case *updateItem:
 		if *id == "" || *name == "" || *quantity < 0 || *price <= 0 {
 			log.Fatal("Required flags for -updateitem: -id, -name, -quantity, -price")
 		}
+		unitPrice, err := money.ToMinor(*price, *currency)
+		if err != nil {
+			log.Fatalf("Invalid -currency %q: %v", *currency, err)
+		}
 		req := &supplychain.UpdateItemRequest{
 			Id:          *id,
 			Name:        *name,
 			Description: *description,
 			Quantity:    int32(*quantity),
 			UnitPrice: &supplychain.Amount{
-				Value:    int64(*price * 100),
+				Value:    unitPrice,
 				Currency: *currency,
 			},
+			TaxCategory: *taxCategory,
 		}
 		resp, err := client.UpdateItem(ctx, req)
 		if err != nil {
 			log.Fatalf("Failed to update item: %v", err)
 		}
-		fmt.Printf("Updated item: %s (ID: %s), Quantity: %d, Unit Price: %s %s\n",
-			resp.Item.Name, resp.Item.Id, resp.Item.Quantity,
+		fmt.Printf("Updated item: %s (ID: %s), Quantity: %d (Reserved: %d, Available: %d), Unit Price: %s %s\n",
+			resp.Item.Name, resp.Item.Id, resp.Item.Quantity, resp.Item.ReservedQuantity, resp.Item.AvailableQuantity,
 			resp.Item.UnitPrice.DisplayValue, resp.Item.UnitPrice.Currency)
 
 	case *deleteItem:
@@ -122,32 +530,56 @@ func main() {
 		fmt.Printf("Deleted item: Success=%v\n", resp.Success)
 
 	case *createOrder:
-		if *customer == "" || *itemID == "" || *quantity <= 0 {
-			log.Fatal("Required flags for -createorder: -customer, -item, -quantity")
+		// customer keys can leave out -customer, they order for their own customer
+		if *addressID == "" || *itemID == "" || *quantity <= 0 {
+			log.Fatal("Required flags for -createorder: -address, -item, -quantity (and -customer for admin keys)")
 		}
 		req := &supplychain.CreateOrderRequest{
-			CustomerId: *customer,
+			CustomerId:      *customer,
+			ShipToAddressId: *addressID,
 			Items: []*supplychain.OrderItem{
 				{ItemId: *itemID, Quantity: int32(*quantity)},
 			},
+			SettlementCurrency: *settle,
+		}
+		if *coupons != "" {
+			req.CouponCodes = strings.Split(*coupons, ",")
 		}
 		resp, err := client.CreateOrder(ctx, req)
 		if err != nil {
-			log.Fatalf("Failed to create order: %v", err)
+			fatalWithDetails("Failed to create order", err)
 		}
-		fmt.Printf("Created order: %s, Total: %s %s\n",
-			resp.Order.Id, resp.Order.Total.DisplayValue, resp.Order.Total.Currency)
+		fmt.Printf("Created order: %s, Discount: %s, Subtotal: %s, Tax: %s, Total: %s %s\n",
+			resp.Order.Id, resp.Order.DiscountTotal.DisplayValue, resp.Order.Subtotal.DisplayValue, resp.Order.TaxTotal.DisplayValue,
+			resp.Order.Total.DisplayValue, resp.Order.Total.Currency)
 
 	case *fulfillOrder:
 		if *orderID == "" {
 			log.Fatal("Required flag for -fulfillorder: -order")
 		}
 		req := &supplychain.FulfillOrderRequest{OrderId: *orderID}
+		if *partial {
+			req.Mode = supplychain.FulfillmentMode_FULFILLMENT_MODE_PARTIAL
+		}
 		resp, err := client.FulfillOrder(ctx, req)
 		if err != nil {
-			log.Fatalf("Failed to fulfill order: %v", err)
+			fatalWithDetails("Failed to fulfill order", err)
 		}
 		fmt.Printf("Fulfilled order: %s, Status: %s\n", resp.Order.Id, resp.Order.Status)
+		for _, line := range resp.Backordered {
+			fmt.Printf("  Backordered: Item %s, Requested: %d, Available: %d\n", line.ItemId, line.Requested, line.Available)
+		}
+
+	case *cancelOrder:
+		if *orderID == "" {
+			log.Fatal("Required flag for -cancelorder: -order")
+		}
+		req := &supplychain.CancelOrderRequest{OrderId: *orderID, Reason: *reason}
+		resp, err := client.CancelOrder(ctx, req)
+		if err != nil {
+			log.Fatalf("Failed to cancel order: %v", err)
+		}
+		fmt.Printf("Cancelled order: %s, Status: %s\n", resp.Order.Id, resp.Order.Status)
 
 	case *getOrder:
 		if *orderID == "" {
@@ -158,9 +590,138 @@ func main() {
 		if err != nil {
 			log.Fatalf("Failed to get order: %v", err)
 		}
-		fmt.Printf("Order: %s, Customer: %s, Total: %s %s, Status: %s\n",
-			resp.Order.Id, resp.Order.CustomerId,
-			resp.Order.Total.DisplayValue, resp.Order.Total.Currency, resp.Order.Status)
+		fmt.Printf("Order: %s, Customer: %s, Discount: %s, Subtotal: %s, Tax: %s, Total: %s %s, Status: %s\n",
+			resp.Order.Id, resp.Order.CustomerId, resp.Order.DiscountTotal.DisplayValue, resp.Order.Subtotal.DisplayValue,
+			resp.Order.TaxTotal.DisplayValue, resp.Order.Total.DisplayValue, resp.Order.Total.Currency, resp.Order.Status)
+		if len(resp.Order.CouponCodes) > 0 {
+			fmt.Printf("  Coupons: %s\n", strings.Join(resp.Order.CouponCodes, ", "))
+		}
+		if resp.Order.ShipTo != nil {
+			fmt.Printf("  Ship to: %s\n", formatAddress(resp.Order.ShipTo))
+		}
+		if resp.Order.TaxJurisdiction != "" {
+			fmt.Printf("  Tax region: %s, Taxed under: %s, Prices include tax: %v\n",
+				resp.Order.ShipToRegion, resp.Order.TaxJurisdiction, resp.Order.PricesIncludeTax)
+		}
+		for _, line := range resp.Order.Items {
+			fmt.Printf("  Item: %s (%s), Quantity: %d, Fulfilled: %d, Shipped: %d, Outstanding: %d",
+				line.Name, line.ItemId, line.Quantity, line.FulfilledQuantity, line.ShippedQuantity, line.OutstandingQuantity)
+			if line.UnitPrice != nil {
+				fmt.Printf(", Unit Price: %s, Line Total: %s %s", line.UnitPrice.DisplayValue, line.LineTotal.DisplayValue, line.LineTotal.Currency)
+			}
+			if line.OriginalUnitPrice != nil {
+				fmt.Printf(" (from %s %s at %s)", line.OriginalLineTotal.DisplayValue, line.OriginalLineTotal.Currency, line.ExchangeRate)
+			}
+			if line.Tax != nil {
+				fmt.Printf(", Tax: %s (%s at %s)", line.Tax.DisplayValue, line.TaxCategory, line.TaxRate)
+			}
+			fmt.Println()
+			for _, discount := range line.Discounts {
+				fmt.Printf("    Discount: -%s %s\n", discount.Amount.DisplayValue, discount.Name)
+			}
+		}
+		for _, event := range resp.History {
+			t := time.Unix(event.Timestamp, 0).Format(time.RFC3339)
+			fmt.Printf("  %s %s: %s -> %s %s\n", t, event.Type, event.FromStatus, event.ToStatus, event.Note)
+		}
+
+	case *watchOrder:
+		if *orderID == "" {
+			log.Fatal("Required flag for -watchorder: -order")
+		}
+		stream, err := client.WatchOrder(ctx, &supplychain.WatchOrderRequest{Id: *orderID, AfterEventId: *afterEvent})
+		if err != nil {
+			log.Fatalf("Failed to watch order: %v", err)
+		}
+		for {
+			event, err := stream.Recv()
+			if err == io.EOF {
+				fmt.Println("Order is done")
+				break
+			}
+			if err != nil {
+				log.Fatalf("Order watch ended: %v", err)
+			}
+			t := time.Unix(event.Timestamp, 0).Format(time.RFC3339)
+			fmt.Printf("  %s [%d] %s: %s -> %s %s\n", t, event.Id, event.Type, event.FromStatus, event.ToStatus, event.Note)
+		}
+
+	case *pickSession:
+		stream, err := client.PickSession(ctx)
+		if err != nil {
+			log.Fatalf("Failed to start pick session: %v", err)
+		}
+		go func() {
+			scanner := bufio.NewScanner(os.Stdin)
+			for scanner.Scan() {
+				if strings.TrimSpace(scanner.Text()) == "" {
+					continue
+				}
+				req, err := parseScan(scanner.Text())
+				if err != nil {
+					fmt.Printf("Invalid scan: %v\n", err)
+					continue
+				}
+				if err := stream.Send(req); err != nil {
+					return // Recv reports why
+				}
+			}
+			stream.CloseSend()
+		}()
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				log.Fatalf("Pick session ended: %v", err)
+			}
+			printPick(resp)
+		}
+
+	case *listOrders:
+		req := &supplychain.ListOrdersRequest{
+			CustomerId: *customer,
+			Status:     *status,
+			ItemId:     *itemID,
+			Descending: *desc,
+			PageSize:   int32(*pageSize),
+			PageToken:  *pageToken,
+		}
+		switch *sortBy {
+		case "created":
+		case "total":
+			req.SortBy = supplychain.OrderSortField_ORDER_SORT_TOTAL
+		default:
+			log.Fatal("-sort must be created or total")
+		}
+		if *after != "" {
+			t, err := time.Parse(time.RFC3339, *after)
+			if err != nil {
+				log.Fatalf("Invalid -after: %v", err)
+			}
+			req.CreatedAfter = t.Unix()
+		}
+		if *before != "" {
+			t, err := time.Parse(time.RFC3339, *before)
+			if err != nil {
+				log.Fatalf("Invalid -before: %v", err)
+			}
+			req.CreatedBefore = t.Unix()
+		}
+		resp, err := client.ListOrders(ctx, req)
+		if err != nil {
+			log.Fatalf("Failed to list orders: %v", err)
+		}
+		fmt.Printf("Listed %d orders:\n", len(resp.Orders))
+		for _, order := range resp.Orders {
+			t := time.Unix(order.CreatedAt, 0).Format(time.RFC3339)
+			fmt.Printf("  Order: %s, Customer: %s, Total: %s %s, Status: %s, Created: %s\n",
+				order.Id, order.CustomerId, order.Total.DisplayValue, order.Total.Currency, order.Status, t)
+		}
+		if resp.NextPageToken != "" {
+			fmt.Printf("Next page: -pagetoken %s\n", resp.NextPageToken)
+		}
 
 	case *createShipment:
 		if *orderID == "" || *trackingNumber == "" {
@@ -170,21 +731,35 @@ func main() {
 			OrderId:        *orderID,
 			TrackingNumber: *trackingNumber,
 		}
+		if *lines != "" {
+			req.Items, err = parseLines(*lines)
+			if err != nil {
+				log.Fatalf("Invalid -lines: %v", err)
+			}
+		}
 		resp, err := client.CreateShipment(ctx, req)
 		if err != nil {
 			log.Fatalf("Failed to create shipment: %v", err)
 		}
 		fmt.Printf("Created shipment: %s, Order: %s, Tracking: %s, Status: %s\n",
 			resp.Shipment.Id, resp.Shipment.OrderId, resp.Shipment.TrackingNumber, resp.Shipment.Status)
+		if resp.Shipment.ShipTo != nil {
+			fmt.Printf("  Ship to: %s\n", formatAddress(resp.Shipment.ShipTo))
+		}
+		for _, item := range resp.Shipment.Items {
+			fmt.Printf("  Item: %s, Quantity: %d\n", item.ItemId, item.Quantity)
+		}
 	
 	case *updateShipment:
-		if *id == "" || *status == "" || *trackingNumber == "" {
-			log.Fatal("Required flags for -updateshipment: -id, -status -tracking")
+		if *id == "" || *status == "" {
+			log.Fatal("Required flags for -updateshipment: -id, -status")
 		}
 		req := &supplychain.UpdateShipmentRequest{
 			Id:             *id,
 			Status:         *status,
 			TrackingNumber: *trackingNumber,
+			Location:       *location,
+			Note:           *note,
 		}
 		resp, err := client.UpdateShipment(ctx, req)
 		if err != nil {
@@ -193,6 +768,48 @@ func main() {
 		fmt.Printf("Updated shipment: %s, Status: %s, Tracking: %s\n",
 			resp.Shipment.Id, resp.Shipment.Status, resp.Shipment.TrackingNumber)
 	
+	case *getShipment:
+		if *id == "" {
+			log.Fatal("Required flag for -getshipment: -id")
+		}
+		resp, err := client.GetShipment(ctx, &supplychain.GetShipmentRequest{Id: *id})
+		if err != nil {
+			log.Fatalf("Failed to get shipment: %v", err)
+		}
+		fmt.Printf("Shipment: %s, Order: %s, Tracking: %s, Status: %s\n",
+			resp.Shipment.Id, resp.Shipment.OrderId, resp.Shipment.TrackingNumber, resp.Shipment.Status)
+		if resp.Shipment.ShipTo != nil {
+			fmt.Printf("  Ship to: %s\n", formatAddress(resp.Shipment.ShipTo))
+		}
+		for _, item := range resp.Shipment.Items {
+			fmt.Printf("  Item: %s, Quantity: %d\n", item.ItemId, item.Quantity)
+		}
+		for _, event := range resp.Events {
+			t := time.Unix(event.Timestamp, 0).Format(time.RFC3339)
+			fmt.Printf("  %s %s -> %s %s %s\n", t, event.FromStatus, event.ToStatus, event.Location, event.Note)
+		}
+
+	case *watchShipment:
+		if *id == "" {
+			log.Fatal("Required flag for -watchshipment: -id")
+		}
+		stream, err := client.WatchShipment(ctx, &supplychain.WatchShipmentRequest{Id: *id, AfterEventId: *afterEvent})
+		if err != nil {
+			log.Fatalf("Failed to watch shipment: %v", err)
+		}
+		for {
+			event, err := stream.Recv()
+			if err == io.EOF {
+				fmt.Println("Shipment is done")
+				break
+			}
+			if err != nil {
+				log.Fatalf("Shipment watch ended: %v", err)
+			}
+			t := time.Unix(event.Timestamp, 0).Format(time.RFC3339)
+			fmt.Printf("  %s [%d] %s -> %s %s %s\n", t, event.Id, event.FromStatus, event.ToStatus, event.Location, event.Note)
+		}
+
 	case *listItems:
 		req := &supplychain.ListItemsRequest{
 			NameFilter: *nameFilter,
@@ -205,11 +822,137 @@ func main() {
 		}
 		fmt.Printf("Listed %d items (Total: %d):\n", len(resp.Items), resp.Total)
 		for _, item := range resp.Items {
-			fmt.Printf("  Item: %s (ID: %s), Quantity: %d, Unit Price: %s %s\n",
-				item.Name, item.Id, item.Quantity,
+			fmt.Printf("  Item: %s (ID: %s), Quantity: %d (Reserved: %d, Available: %d), Unit Price: %s %s\n",
+				item.Name, item.Id, item.Quantity, item.ReservedQuantity, item.AvailableQuantity,
 				item.UnitPrice.DisplayValue, item.UnitPrice.Currency)
 		}
 	
+	case *watchInventory:
+		req := &supplychain.WatchInventoryRequest{NameFilter: *nameFilter, ResumeToken: *resumeToken}
+		if *items != "" {
+			req.ItemIds = strings.Split(*items, ",")
+		}
+		stream, err := client.WatchInventory(ctx, req)
+		if err != nil {
+			log.Fatalf("Failed to watch inventory: %v", err)
+		}
+		fmt.Println("Watching inventory, Ctrl-C to stop")
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				log.Fatalf("Inventory watch ended: %v", err)
+			}
+			t := time.Unix(event.OccurredAt, 0).Format(time.RFC3339)
+			fmt.Printf("  %s %s: %s (ID: %s), Quantity: %d (%+d), %s\n    Resume: %s\n",
+				t, strings.TrimPrefix(event.Type.String(), "INVENTORY_EVENT_"), event.Name, event.ItemId,
+				event.Quantity, event.QuantityDelta, event.Reason, event.ResumeToken)
+		}
+
+	case *importItems:
+		if *file == "" {
+			log.Fatal("Required flag for -importitems: -file")
+		}
+		data, err := os.ReadFile(*file)
+		if err != nil {
+			log.Fatalf("Failed to read %s: %v", *file, err)
+		}
+		rows, lines, err := parseItemsCSV(data)
+		if err != nil {
+			log.Fatalf("Invalid items file: %v", err)
+		}
+		if len(rows) == 0 {
+			log.Fatal("No items in " + *file)
+		}
+		rows[0].DryRun = *dryRun
+		stream, err := client.ImportItems(ctx)
+		if err != nil {
+			log.Fatalf("Failed to import items: %v", err)
+		}
+		for _, row := range rows {
+			if err := stream.Send(row); err != nil {
+				break // the real error comes from CloseAndRecv
+			}
+		}
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			log.Fatalf("Failed to import items: %v", err)
+		}
+		if resp.DryRun {
+			fmt.Print("Dry run, nothing written: ")
+		}
+		fmt.Printf("Created %d, Updated %d, Rejected %d items\n", resp.Created, resp.Updated, resp.Rejected)
+		for _, e := range resp.Errors {
+			if e.Id != "" {
+				fmt.Printf("  Line %d: %s (ID: %s)\n", lines[e.Row-1], e.Reason, e.Id)
+			} else {
+				fmt.Printf("  Line %d: %s\n", lines[e.Row-1], e.Reason)
+			}
+		}
+
+	case *batchUpdateItems:
+		if *file == "" {
+			log.Fatal("Required flag for -batchupdateitems: -file")
+		}
+		data, err := os.ReadFile(*file)
+		if err != nil {
+			log.Fatalf("Failed to read %s: %v", *file, err)
+		}
+		rows, _, err := parseItemsCSV(data)
+		if err != nil {
+			log.Fatalf("Invalid items file: %v", err)
+		}
+		req := &supplychain.BatchUpdateItemsRequest{Mode: batchMode(*bestEffort)}
+		for _, row := range rows {
+			req.Items = append(req.Items, &supplychain.UpdateItemRequest{
+				Id:          row.Id,
+				Name:        row.Name,
+				Description: row.Description,
+				Quantity:    row.Quantity,
+				UnitPrice:   row.UnitPrice,
+				TaxCategory: row.TaxCategory,
+			})
+		}
+		resp, err := client.BatchUpdateItems(ctx, req)
+		if err != nil {
+			log.Fatalf("Failed to update items: %v", err)
+		}
+		printBatch("Updated items", resp.Succeeded, resp.Failed, resp.Results)
+
+	case *batchGetOrders:
+		if *ids == "" {
+			log.Fatal("Required flag for -batchgetorders: -ids")
+		}
+		req := &supplychain.BatchGetOrdersRequest{Ids: strings.Split(*ids, ","), Mode: batchMode(*bestEffort)}
+		resp, err := client.BatchGetOrders(ctx, req)
+		if err != nil {
+			log.Fatalf("Failed to get orders: %v", err)
+		}
+		for _, r := range resp.Results {
+			if r.Order != nil {
+				fmt.Printf("  Order: %s, Customer: %s, Status: %s, Total: %s %s\n",
+					r.Order.Id, r.Order.CustomerId, r.Order.Status, r.Order.Total.DisplayValue, r.Order.Total.Currency)
+			}
+		}
+		printBatch("Got orders", resp.Succeeded, resp.Failed, resp.Results)
+
+	case *batchUpdateShipments:
+		if *file == "" {
+			log.Fatal("Required flag for -batchupdateshipments: -file")
+		}
+		data, err := os.ReadFile(*file)
+		if err != nil {
+			log.Fatalf("Failed to read %s: %v", *file, err)
+		}
+		updates, err := parseShipmentsCSV(data)
+		if err != nil {
+			log.Fatalf("Invalid shipments file: %v", err)
+		}
+		resp, err := client.BatchUpdateShipments(ctx, &supplychain.BatchUpdateShipmentsRequest{Shipments: updates, Mode: batchMode(*bestEffort)})
+		if err != nil {
+			log.Fatalf("Failed to update shipments: %v", err)
+		}
+		printBatch("Updated shipments", resp.Succeeded, resp.Failed, resp.Results)
+
 	case *listShipments:
 		req := &supplychain.ListShipmentsRequest{
 			OrderId:  *orderID,
@@ -226,6 +969,362 @@ func main() {
 				shipment.Id, shipment.OrderId, shipment.TrackingNumber, shipment.Status)
 		}
 
+	case *createReturn:
+		if *orderID == "" || *lines == "" {
+			log.Fatal("Required flags for -createreturn: -order, -lines")
+		}
+		orderLines, err := parseLines(*lines)
+		if err != nil {
+			log.Fatalf("Invalid -lines: %v", err)
+		}
+		req := &supplychain.CreateReturnRequest{OrderId: *orderID, Reason: *reason}
+		for _, line := range orderLines {
+			req.Items = append(req.Items, &supplychain.ReturnItem{ItemId: line.ItemId, Quantity: line.Quantity, ReasonCode: *reasonCode})
+		}
+		resp, err := client.CreateReturn(ctx, req)
+		if err != nil {
+			log.Fatalf("Failed to create return: %v", err)
+		}
+		printReturn("Created", resp.Return)
+
+	case *approveReturn:
+		if *id == "" {
+			log.Fatal("Required flag for -approvereturn: -id")
+		}
+		req := &supplychain.ApproveReturnRequest{Id: *id, Approve: !*reject, Note: *note}
+		resp, err := client.ApproveReturn(ctx, req)
+		if err != nil {
+			log.Fatalf("Failed to review return: %v", err)
+		}
+		printReturn("Reviewed", resp.Return)
+
+	case *receiveReturn:
+		if *id == "" || *condition == "" {
+			log.Fatal("Required flags for -receivereturn: -id, -condition")
+		}
+		req := &supplychain.ReceiveReturnRequest{Id: *id, Condition: *condition}
+		resp, err := client.ReceiveReturn(ctx, req)
+		if err != nil {
+			log.Fatalf("Failed to receive return: %v", err)
+		}
+		printReturn("Received", resp.Return)
+
+	case *getReturn:
+		if *id == "" {
+			log.Fatal("Required flag for -getreturn: -id")
+		}
+		resp, err := client.GetReturn(ctx, &supplychain.GetReturnRequest{Id: *id})
+		if err != nil {
+			log.Fatalf("Failed to get return: %v", err)
+		}
+		printReturn("", resp.Return)
+
+	case *addRate:
+		if *base == "" || *quote == "" || *rate == "" {
+			log.Fatal("Required flags for -addrate: -base, -quote, -rate")
+		}
+		effectiveAt := time.Now()
+		if *effective != "" {
+			effectiveAt, err = time.Parse(time.RFC3339, *effective)
+			if err != nil {
+				log.Fatalf("Invalid -effective: %v", err)
+			}
+		}
+		req := &supplychain.AddExchangeRatesRequest{
+			Rates: []*supplychain.ExchangeRate{
+				{BaseCurrency: *base, QuoteCurrency: *quote, Rate: *rate, EffectiveAt: effectiveAt.Unix()},
+			},
+		}
+		if _, err := client.AddExchangeRates(ctx, req); err != nil {
+			log.Fatalf("Failed to add exchange rate: %v", err)
+		}
+		fmt.Printf("Added rate: 1 %s = %s %s from %s\n", *base, *rate, *quote, effectiveAt.Format(time.RFC3339))
+
+	case *importRates:
+		if *file == "" {
+			log.Fatal("Required flag for -importrates: -file")
+		}
+		data, err := os.ReadFile(*file)
+		if err != nil {
+			log.Fatalf("Failed to read %s: %v", *file, err)
+		}
+		resp, err := client.ImportExchangeRates(ctx, &supplychain.ImportExchangeRatesRequest{Csv: data})
+		if err != nil {
+			log.Fatalf("Failed to import exchange rates: %v", err)
+		}
+		fmt.Printf("Imported %d exchange rates\n", resp.Imported)
+
+	case *listRates:
+		req := &supplychain.ListExchangeRatesRequest{BaseCurrency: *base, QuoteCurrency: *quote}
+		if *asOf != "" {
+			t, err := time.Parse(time.RFC3339, *asOf)
+			if err != nil {
+				log.Fatalf("Invalid -asof: %v", err)
+			}
+			req.AsOf = t.Unix()
+		}
+		resp, err := client.ListExchangeRates(ctx, req)
+		if err != nil {
+			log.Fatalf("Failed to list exchange rates: %v", err)
+		}
+		fmt.Printf("Listed %d exchange rates:\n", len(resp.Rates))
+		for _, r := range resp.Rates {
+			t := time.Unix(r.EffectiveAt, 0).Format(time.RFC3339)
+			fmt.Printf("  1 %s = %s %s from %s\n", r.BaseCurrency, r.Rate, r.QuoteCurrency, t)
+		}
+
+	case *createPromo:
+		if *name == "" {
+			log.Fatal("Required flag for -createpromo: -name")
+		}
+		promotion := &supplychain.Promotion{
+			Name:        *name,
+			Code:        *code,
+			PercentOff:  *percent,
+			BuyQuantity: int32(*buy),
+			GetQuantity: int32(*get),
+			UsageLimit:  int32(*limit),
+		}
+		switch *promoType {
+		case "percentage":
+		case "fixed":
+			promotion.Type = supplychain.PromotionType_PROMOTION_TYPE_FIXED_AMOUNT
+			amountOff, err := money.ToMinor(*price, *currency)
+			if err != nil {
+				log.Fatalf("Invalid -currency %q: %v", *currency, err)
+			}
+			promotion.AmountOff = &supplychain.Amount{Value: amountOff, Currency: *currency}
+		case "bxgy":
+			promotion.Type = supplychain.PromotionType_PROMOTION_TYPE_BUY_X_GET_Y
+		case "tiered":
+			promotion.Type = supplychain.PromotionType_PROMOTION_TYPE_TIERED_VOLUME
+			for _, part := range strings.Split(*tiers, ",") {
+				minQuantity, pct, ok := strings.Cut(strings.TrimSpace(part), ":")
+				n, err := strconv.Atoi(minQuantity)
+				if !ok || err != nil {
+					log.Fatalf("Invalid -tiers: expected minquantity:percent, got %q", part)
+				}
+				promotion.Tiers = append(promotion.Tiers, &supplychain.DiscountTier{MinQuantity: int32(n), PercentOff: pct})
+			}
+		default:
+			log.Fatal("-type must be percentage, fixed, bxgy or tiered")
+		}
+		if *items != "" {
+			promotion.ItemIds = strings.Split(*items, ",")
+		}
+		if *starts != "" {
+			t, err := time.Parse(time.RFC3339, *starts)
+			if err != nil {
+				log.Fatalf("Invalid -starts: %v", err)
+			}
+			promotion.StartsAt = t.Unix()
+		}
+		if *ends != "" {
+			t, err := time.Parse(time.RFC3339, *ends)
+			if err != nil {
+				log.Fatalf("Invalid -ends: %v", err)
+			}
+			promotion.EndsAt = t.Unix()
+		}
+		resp, err := client.CreatePromotion(ctx, &supplychain.CreatePromotionRequest{Promotion: promotion})
+		if err != nil {
+			log.Fatalf("Failed to create promotion: %v", err)
+		}
+		printPromotion("Created promotion", resp.Promotion)
+
+	case *listPromos:
+		resp, err := client.ListPromotions(ctx, &supplychain.ListPromotionsRequest{IncludeInactive: *all})
+		if err != nil {
+			log.Fatalf("Failed to list promotions: %v", err)
+		}
+		fmt.Printf("Listed %d promotions:\n", len(resp.Promotions))
+		for _, promotion := range resp.Promotions {
+			printPromotion("  Promotion", promotion)
+		}
+
+	case *deactivatePromo:
+		if *id == "" {
+			log.Fatal("Required flag for -deactivatepromo: -id")
+		}
+		resp, err := client.DeactivatePromotion(ctx, &supplychain.DeactivatePromotionRequest{Id: *id})
+		if err != nil {
+			log.Fatalf("Failed to deactivate promotion: %v", err)
+		}
+		printPromotion("Deactivated promotion", resp.Promotion)
+
+	case *createCustomer:
+		if *name == "" {
+			log.Fatal("Required flag for -createcustomer: -name")
+		}
+		req := &supplychain.CreateCustomerRequest{Id: *customer, Name: *name, Email: *email, Phone: *phone}
+		if *line1 != "" {
+			req.Addresses = []*supplychain.Address{address()}
+		}
+		resp, err := client.CreateCustomer(ctx, req)
+		if err != nil {
+			log.Fatalf("Failed to create customer: %v", err)
+		}
+		printCustomer("Created customer", resp.Customer)
+
+	case *getCustomer:
+		if *customer == "" {
+			log.Fatal("Required flag for -getcustomer: -customer")
+		}
+		resp, err := client.GetCustomer(ctx, &supplychain.GetCustomerRequest{Id: *customer})
+		if err != nil {
+			log.Fatalf("Failed to get customer: %v", err)
+		}
+		printCustomer("Customer", resp.Customer)
+
+	case *updateCustomer:
+		if *customer == "" || *name == "" {
+			log.Fatal("Required flags for -updatecustomer: -customer, -name")
+		}
+		resp, err := client.UpdateCustomer(ctx, &supplychain.UpdateCustomerRequest{Id: *customer, Name: *name, Email: *email, Phone: *phone})
+		if err != nil {
+			log.Fatalf("Failed to update customer: %v", err)
+		}
+		printCustomer("Updated customer", resp.Customer)
+
+	case *deleteCustomer:
+		if *customer == "" {
+			log.Fatal("Required flag for -deletecustomer: -customer")
+		}
+		resp, err := client.DeleteCustomer(ctx, &supplychain.DeleteCustomerRequest{Id: *customer})
+		if err != nil {
+			log.Fatalf("Failed to delete customer: %v", err)
+		}
+		fmt.Printf("Deleted customer: Success=%v\n", resp.Success)
+
+	case *listCustomers:
+		req := &supplychain.ListCustomersRequest{
+			NameFilter: *nameFilter,
+			Page:       int32(*page),
+			PageSize:   int32(*pageSize),
+		}
+		resp, err := client.ListCustomers(ctx, req)
+		if err != nil {
+			log.Fatalf("Failed to list customers: %v", err)
+		}
+		fmt.Printf("Listed %d customers (Total: %d):\n", len(resp.Customers), resp.Total)
+		for _, c := range resp.Customers {
+			printCustomer("  Customer", c)
+		}
+
+	case *addAddress:
+		if *customer == "" || *line1 == "" || *city == "" || *country == "" {
+			log.Fatal("Required flags for -addaddress: -customer, -line1, -city, -country")
+		}
+		resp, err := client.AddCustomerAddress(ctx, &supplychain.AddCustomerAddressRequest{CustomerId: *customer, Address: address()})
+		if err != nil {
+			log.Fatalf("Failed to add address: %v", err)
+		}
+		printCustomer("Updated customer", resp.Customer)
+
+	case *removeAddress:
+		if *customer == "" || *addressID == "" {
+			log.Fatal("Required flags for -removeaddress: -customer, -address")
+		}
+		resp, err := client.RemoveCustomerAddress(ctx, &supplychain.RemoveCustomerAddressRequest{CustomerId: *customer, AddressId: *addressID})
+		if err != nil {
+			log.Fatalf("Failed to remove address: %v", err)
+		}
+		printCustomer("Updated customer", resp.Customer)
+
+	case *createKey:
+		if *role == "" {
+			log.Fatal("Required flags for -createkey: -role (and -customer for customer keys)")
+		}
+		req := &supplychain.CreateApiKeyRequest{Role: *role, CustomerId: *customer, Description: *description}
+		if *expires != "" {
+			t, err := time.Parse(time.RFC3339, *expires)
+			if err != nil {
+				log.Fatalf("Invalid -expires: %v", err)
+			}
+			req.ExpiresAt = t.Unix()
+		}
+		resp, err := client.CreateApiKey(ctx, req)
+		if err != nil {
+			log.Fatalf("Failed to create API key: %v", err)
+		}
+		printAPIKey("Created API key", resp.ApiKey)
+		fmt.Printf("Key: %s (store it now, it can't be shown again)\n", resp.Key)
+
+	case *listKeys:
+		resp, err := client.ListApiKeys(ctx, &supplychain.ListApiKeysRequest{IncludeRevoked: *all})
+		if err != nil {
+			log.Fatalf("Failed to list API keys: %v", err)
+		}
+		fmt.Printf("Listed %d API keys:\n", len(resp.ApiKeys))
+		for _, k := range resp.ApiKeys {
+			printAPIKey("  API key", k)
+		}
+
+	case *revokeKey:
+		if *id == "" {
+			log.Fatal("Required flag for -revokekey: -id")
+		}
+		resp, err := client.RevokeApiKey(ctx, &supplychain.RevokeApiKeyRequest{Id: *id})
+		if err != nil {
+			log.Fatalf("Failed to revoke API key: %v", err)
+		}
+		printAPIKey("Revoked API key", resp.ApiKey)
+
+	case *rotateKey:
+		if *id == "" {
+			log.Fatal("Required flag for -rotatekey: -id")
+		}
+		resp, err := client.RotateApiKey(ctx, &supplychain.RotateApiKeyRequest{Id: *id, GraceSeconds: int64(grace.Seconds())})
+		if err != nil {
+			log.Fatalf("Failed to rotate API key: %v", err)
+		}
+		printAPIKey("Old API key", resp.Previous)
+		printAPIKey("New API key", resp.ApiKey)
+		fmt.Printf("Key: %s (store it now, it can't be shown again)\n", resp.Key)
+
+	case *listRoles:
+		resp, err := client.ListRoles(ctx, &supplychain.ListRolesRequest{})
+		if err != nil {
+			log.Fatalf("Failed to list roles: %v", err)
+		}
+		fmt.Printf("Listed %d roles (from policy file: %v):\n", len(resp.Roles), resp.FromFile)
+		for _, role := range resp.Roles {
+			fmt.Printf("  Role: %s, Permissions: %s, %s\n", role.Name, strings.Join(role.Permissions, ","), role.Description)
+		}
+
+	case *putRole:
+		if *name == "" {
+			log.Fatal("Required flags for -putrole: -name, -permissions")
+		}
+		role := &supplychain.Role{Name: *name, Description: *description}
+		if *permissions != "" {
+			role.Permissions = strings.Split(*permissions, ",")
+		}
+		resp, err := client.PutRole(ctx, &supplychain.PutRoleRequest{Role: role})
+		if err != nil {
+			log.Fatalf("Failed to put role: %v", err)
+		}
+		fmt.Printf("Stored role: %s, Permissions: %s\n", resp.Role.Name, strings.Join(resp.Role.Permissions, ","))
+
+	case *deleteRole:
+		if *name == "" {
+			log.Fatal("Required flag for -deleterole: -name")
+		}
+		resp, err := client.DeleteRole(ctx, &supplychain.DeleteRoleRequest{Name: *name})
+		if err != nil {
+			log.Fatalf("Failed to delete role: %v", err)
+		}
+		fmt.Printf("Deleted role: Success=%v\n", resp.Success)
+
+	case *listPerms:
+		resp, err := client.ListPermissions(ctx, &supplychain.ListPermissionsRequest{})
+		if err != nil {
+			log.Fatalf("Failed to list permissions: %v", err)
+		}
+		for _, permission := range resp.Permissions {
+			fmt.Printf("  %s: %s\n", permission.Name, strings.Join(permission.Methods, ", "))
+		}
+
 	case *audit:
 		if *auditKey == "" {
 			log.Fatal("Required flag for -audit: -auditkey")
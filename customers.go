@@ -0,0 +1,420 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Scrimzay/supplychain/supplychain"
+)
+
+// countryCode matches an ISO 3166 alpha-2 country code
+var countryCode = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// addressColumns are the columns an address is stored in, in the order
+// addressValues returns them
+const addressColumns = "name, line1, line2, city, region, postal_code, country"
+
+// normalizeAddress trims an address and checks it has enough to deliver to
+func normalizeAddress(a *supplychain.Address) error {
+	if a == nil {
+		return status.Error(codes.InvalidArgument, "Address required")
+	}
+	for _, field := range []*string{&a.Name, &a.Line1, &a.Line2, &a.City, &a.Region, &a.PostalCode, &a.Country} {
+		*field = strings.TrimSpace(*field)
+	}
+	a.Region = strings.ToUpper(a.Region)
+	a.Country = strings.ToUpper(a.Country)
+	if a.Line1 == "" || a.City == "" {
+		return status.Error(codes.InvalidArgument, "Address needs at least line1 and city")
+	}
+	if !countryCode.MatchString(a.Country) {
+		return status.Errorf(codes.InvalidArgument, "Invalid country code %q", a.Country)
+	}
+	if _, ok := supplychain.AddressType_name[int32(a.Type)]; !ok {
+		return status.Error(codes.InvalidArgument, "Unknown address type")
+	}
+	return nil
+}
+
+// addressValues returns the values of an address for addressColumns
+func addressValues(a *supplychain.Address) []interface{} {
+	return []interface{}{a.Name, a.Line1, a.Line2, a.City, a.Region, a.PostalCode, a.Country}
+}
+
+// taxRegion is the region an address is taxed in, e.g. "US-CA", or just
+// the country when the address has no region
+func taxRegion(a *supplychain.Address) string {
+	if a.Region == "" {
+		return a.Country
+	}
+	return a.Country + "-" + a.Region
+}
+
+// addAddress validates an address and stores it for a customer
+func addAddress(ctx context.Context, tx *sql.Tx, customerID string, a *supplychain.Address, now int64) error {
+	if err := normalizeAddress(a); err != nil {
+		return err
+	}
+	a.Id = uuid.New().String()
+	_, err := tx.ExecContext(ctx,
+		"INSERT INTO customer_addresses (id, customer_id, type, "+addressColumns+", created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		append(append([]interface{}{a.Id, customerID, a.Type.String()}, addressValues(a)...), now)...)
+	if err != nil {
+		return status.Error(codes.Internal, "Failed to add address")
+	}
+	return nil
+}
+
+// loadCustomer reads a customer and its addresses, it returns sql.ErrNoRows for unknown ids
+func loadCustomer(ctx context.Context, q queryer, id string) (*supplychain.Customer, error) {
+	var customer supplychain.Customer
+	var email, phone sql.NullString
+	err := q.QueryRowContext(ctx,
+		"SELECT id, name, email, phone, created_at, updated_at FROM customers WHERE id = ?",
+		id).Scan(&customer.Id, &customer.Name, &email, &phone, &customer.CreatedAt, &customer.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	customer.Email = email.String
+	customer.Phone = phone.String
+
+	rows, err := q.QueryContext(ctx,
+		"SELECT id, type, "+addressColumns+" FROM customer_addresses WHERE customer_id = ? ORDER BY rowid", id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var address supplychain.Address
+		var addressType string
+		var name, line2, region, postalCode sql.NullString
+		if err := rows.Scan(&address.Id, &addressType, &name, &address.Line1, &line2, &address.City,
+			&region, &postalCode, &address.Country); err != nil {
+			return nil, err
+		}
+		address.Type = supplychain.AddressType(supplychain.AddressType_value[addressType])
+		address.Name = name.String
+		address.Line2 = line2.String
+		address.Region = region.String
+		address.PostalCode = postalCode.String
+		customer.Addresses = append(customer.Addresses, &address)
+	}
+	return &customer, rows.Err()
+}
+
+// shipToAddress finds the shipping address a new order for a customer goes to
+func shipToAddress(ctx context.Context, q queryer, customerID, addressID string) (*supplychain.Address, error) {
+	customer, err := loadCustomer(ctx, q, customerID)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "Customer not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch customer")
+	}
+	if addressID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Ship-to address required")
+	}
+	for _, address := range customer.Addresses {
+		if address.Id != addressID {
+			continue
+		}
+		if address.Type != supplychain.AddressType_ADDRESS_TYPE_SHIPPING {
+			return nil, status.Error(codes.InvalidArgument, "Ship-to address is not a shipping address")
+		}
+		// the order keeps its own copy, the id would only point at an address that may change
+		address.Id = ""
+		return address, nil
+	}
+	return nil, status.Error(codes.NotFound, "Ship-to address not found for customer")
+}
+
+// loadShipTo reads the address an order was placed to, older orders have none
+func loadShipTo(ctx context.Context, q queryer, orderID string) (*supplychain.Address, error) {
+	var name, line1, line2, city, region, postalCode, country sql.NullString
+	err := q.QueryRowContext(ctx,
+		"SELECT ship_to_name, ship_to_line1, ship_to_line2, ship_to_city, ship_to_region_code, ship_to_postal_code, ship_to_country FROM orders WHERE id = ?",
+		orderID).Scan(&name, &line1, &line2, &city, &region, &postalCode, &country)
+	if err != nil {
+		return nil, err
+	}
+	if !line1.Valid {
+		return nil, nil
+	}
+	return &supplychain.Address{
+		Name:       name.String,
+		Line1:      line1.String,
+		Line2:      line2.String,
+		City:       city.String,
+		Region:     region.String,
+		PostalCode: postalCode.String,
+		Country:    country.String,
+	}, nil
+}
+
+func (s *SupplyChainServer) CreateCustomer(ctx context.Context, req *supplychain.CreateCustomerRequest) (*supplychain.CreateCustomerResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "Customer name required")
+	}
+	id := strings.TrimSpace(req.Id)
+	if id == "" {
+		id = uuid.New().String()
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	var exists int
+	err = tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM customers WHERE id = ?", id).Scan(&exists)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to check customer")
+	}
+	if exists > 0 {
+		return nil, status.Errorf(codes.AlreadyExists, "Customer %s already exists", id)
+	}
+
+	now := time.Now().Unix()
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO customers (id, name, email, phone, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+		id, name, strings.TrimSpace(req.Email), strings.TrimSpace(req.Phone), now, now)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to create customer")
+	}
+	for _, address := range req.Addresses {
+		if err := addAddress(ctx, tx, id, address, now); err != nil {
+			return nil, err
+		}
+	}
+
+	customer, err := loadCustomer(ctx, tx, id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch customer")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to commit transaction")
+	}
+
+	return &supplychain.CreateCustomerResponse{Customer: customer}, nil
+}
+
+func (s *SupplyChainServer) GetCustomer(ctx context.Context, req *supplychain.GetCustomerRequest) (*supplychain.GetCustomerResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "Customer ID required")
+	}
+	if err := checkOwner(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	customer, err := loadCustomer(ctx, s.db, req.Id)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "Customer not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch customer")
+	}
+
+	return &supplychain.GetCustomerResponse{Customer: customer}, nil
+}
+
+func (s *SupplyChainServer) UpdateCustomer(ctx context.Context, req *supplychain.UpdateCustomerRequest) (*supplychain.UpdateCustomerResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if req.Id == "" || name == "" {
+		return nil, status.Error(codes.InvalidArgument, "Invalid customer details")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx,
+		"UPDATE customers SET name = ?, email = ?, phone = ?, updated_at = ? WHERE id = ?",
+		name, strings.TrimSpace(req.Email), strings.TrimSpace(req.Phone), time.Now().Unix(), req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to update customer")
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return nil, status.Error(codes.NotFound, "Customer not found")
+	}
+
+	customer, err := loadCustomer(ctx, tx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch customer")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to commit transaction")
+	}
+
+	return &supplychain.UpdateCustomerResponse{Customer: customer}, nil
+}
+
+func (s *SupplyChainServer) DeleteCustomer(ctx context.Context, req *supplychain.DeleteCustomerRequest) (*supplychain.DeleteCustomerResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "Customer ID required")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	// orders keep pointing at their customer, so only customers without any can go
+	var orders int
+	err = tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM orders WHERE customer_id = ?", req.Id).Scan(&orders)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to check orders")
+	}
+	if orders > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Customer has %d orders", orders)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM customer_addresses WHERE customer_id = ?", req.Id); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to delete addresses")
+	}
+	result, err := tx.ExecContext(ctx, "DELETE FROM customers WHERE id = ?", req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to delete customer")
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return nil, status.Error(codes.NotFound, "Customer not found")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to commit transaction")
+	}
+
+	return &supplychain.DeleteCustomerResponse{Success: true}, nil
+}
+
+func (s *SupplyChainServer) ListCustomers(ctx context.Context, req *supplychain.ListCustomersRequest) (*supplychain.ListCustomersResponse, error) {
+	if req.Page < 1 || req.PageSize < 1 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid pagination")
+	}
+
+	where := " WHERE name LIKE ?"
+	args := []interface{}{"%" + req.NameFilter + "%"}
+	// callers limited to a customer only ever see that one
+	if scope, ok := customerScope(ctx); ok {
+		where += " AND id = ?"
+		args = append(args, scope)
+	}
+	countArgs := args
+
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT id FROM customers"+where+" ORDER BY name, id LIMIT ? OFFSET ?",
+		append(args, req.PageSize, (req.Page-1)*req.PageSize)...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to list customers")
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, status.Error(codes.Internal, "Failed to scan customers")
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+
+	resp := &supplychain.ListCustomersResponse{}
+	for _, id := range ids {
+		customer, err := loadCustomer(ctx, s.db, id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Failed to fetch customer")
+		}
+		resp.Customers = append(resp.Customers, customer)
+	}
+
+	err = s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM customers"+where, countArgs...).Scan(&resp.Total)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to count customers")
+	}
+
+	return resp, nil
+}
+
+func (s *SupplyChainServer) AddCustomerAddress(ctx context.Context, req *supplychain.AddCustomerAddressRequest) (*supplychain.AddCustomerAddressResponse, error) {
+	if req.CustomerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "Customer ID required")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	now := time.Now().Unix()
+	result, err := tx.ExecContext(ctx, "UPDATE customers SET updated_at = ? WHERE id = ?", now, req.CustomerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to update customer")
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return nil, status.Error(codes.NotFound, "Customer not found")
+	}
+	if err := addAddress(ctx, tx, req.CustomerId, req.Address, now); err != nil {
+		return nil, err
+	}
+
+	customer, err := loadCustomer(ctx, tx, req.CustomerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch customer")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to commit transaction")
+	}
+
+	return &supplychain.AddCustomerAddressResponse{Customer: customer}, nil
+}
+
+func (s *SupplyChainServer) RemoveCustomerAddress(ctx context.Context, req *supplychain.RemoveCustomerAddressRequest) (*supplychain.RemoveCustomerAddressResponse, error) {
+	if req.CustomerId == "" || req.AddressId == "" {
+		return nil, status.Error(codes.InvalidArgument, "Customer ID and address ID required")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	// orders placed to the address have their own copy of it
+	result, err := tx.ExecContext(ctx, "DELETE FROM customer_addresses WHERE id = ? AND customer_id = ?", req.AddressId, req.CustomerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to remove address")
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return nil, status.Error(codes.NotFound, "Address not found")
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE customers SET updated_at = ? WHERE id = ?", time.Now().Unix(), req.CustomerId); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to update customer")
+	}
+
+	customer, err := loadCustomer(ctx, tx, req.CustomerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch customer")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to commit transaction")
+	}
+
+	return &supplychain.RemoveCustomerAddressResponse{Customer: customer}, nil
+}
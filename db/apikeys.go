@@ -0,0 +1,345 @@
+package db
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidAPIKey  = errors.New("Invalid API Key")
+	ErrRevokedAPIKey  = errors.New("API key revoked")
+	ErrExpiredAPIKey  = errors.New("API key expired")
+	ErrAPIKeyNotFound = errors.New("API key not found")
+)
+
+// keyPrefix starts every generated key so they are easy to spot, e.g. in a leaked config
+const keyPrefix = "sck_"
+
+// APIKey is a stored key. Only a salted hash of the key is kept, Prefix is
+// the start of it and enough to tell keys apart.
+type APIKey struct {
+	ID          string
+	Prefix      string
+	Role        string
+	CustomerID  string
+	Description string
+	CreatedAt   int64
+	ExpiresAt   int64 // 0 for keys that never expire
+	RevokedAt   int64 // 0 for keys in use
+}
+
+// randomHex returns n random bytes written as hex
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// hashKey hashes a key with its salt
+func hashKey(salt, key string) string {
+	sum := sha256.Sum256([]byte(salt + key))
+	return hex.EncodeToString(sum[:])
+}
+
+// insertAPIKey stores the hash of a key. The prefix is what lookups match
+// the start of a presented key against.
+func insertAPIKey(q interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}, k *APIKey, key string) error {
+	salt, err := randomHex(16)
+	if err != nil {
+		return err
+	}
+	var customerID interface{}
+	if k.CustomerID != "" {
+		customerID = k.CustomerID
+	}
+	_, err = q.Exec(`
+		INSERT INTO api_keys (id, prefix, salt, hash, role, customer_id, description, created_at, expires_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		k.ID, k.Prefix, salt, hashKey(salt, key), k.Role, customerID, k.Description, k.CreatedAt, k.ExpiresAt)
+	return err
+}
+
+// CreateAPIKey makes a new random key. The key itself is returned once
+// and can't be recovered afterwards.
+func (db *DatabaseStruct) CreateAPIKey(role, customerID, description string, expiresAt int64) (*APIKey, string, error) {
+	prefix, err := randomHex(4)
+	if err != nil {
+		return nil, "", err
+	}
+	secret, err := randomHex(24)
+	if err != nil {
+		return nil, "", err
+	}
+	k := &APIKey{
+		ID:          uuid.New().String(),
+		Prefix:      keyPrefix + prefix,
+		Role:        role,
+		CustomerID:  customerID,
+		Description: description,
+		CreatedAt:   time.Now().Unix(),
+		ExpiresAt:   expiresAt,
+	}
+	key := k.Prefix + "_" + secret
+	if err := insertAPIKey(db, k, key); err != nil {
+		return nil, "", err
+	}
+	return k, key, nil
+}
+
+const apiKeyColumns = "id, prefix, role, customer_id, description, created_at, expires_at, revoked_at"
+
+func scanAPIKey(row interface{ Scan(...interface{}) error }) (*APIKey, error) {
+	var k APIKey
+	var customerID, description sql.NullString
+	var revokedAt sql.NullInt64
+	if err := row.Scan(&k.ID, &k.Prefix, &k.Role, &customerID, &description, &k.CreatedAt, &k.ExpiresAt, &revokedAt); err != nil {
+		return nil, err
+	}
+	k.CustomerID = customerID.String
+	k.Description = description.String
+	k.RevokedAt = revokedAt.Int64
+	return &k, nil
+}
+
+// GetAPIKey returns a stored key by id
+func (db *DatabaseStruct) GetAPIKey(id string) (*APIKey, error) {
+	k, err := scanAPIKey(db.QueryRow("SELECT "+apiKeyColumns+" FROM api_keys WHERE id = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, ErrAPIKeyNotFound
+	}
+	return k, err
+}
+
+// ListAPIKeys returns the stored keys, oldest first
+func (db *DatabaseStruct) ListAPIKeys(includeRevoked bool) ([]*APIKey, error) {
+	query := "SELECT " + apiKeyColumns + " FROM api_keys"
+	if !includeRevoked {
+		query += " WHERE revoked_at IS NULL"
+	}
+	rows, err := db.Query(query + " ORDER BY created_at, rowid")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []*APIKey
+	for rows.Next() {
+		k, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+	return keys, rows.Err()
+}
+
+// RevokeAPIKey stops a key from working, revoking it again changes nothing
+func (db *DatabaseStruct) RevokeAPIKey(id string) (*APIKey, error) {
+	result, err := db.Exec("UPDATE api_keys SET revoked_at = ? WHERE id = ? AND revoked_at IS NULL", time.Now().Unix(), id)
+	if err != nil {
+		return nil, err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		if _, err := db.GetAPIKey(id); err != nil {
+			return nil, err
+		}
+	}
+	return db.GetAPIKey(id)
+}
+
+// RotateAPIKey replaces a key with a new one for the same role, customer
+// and expiry. The old key keeps working for grace seconds so callers can
+// switch over, with no grace it is revoked straight away.
+func (db *DatabaseStruct) RotateAPIKey(id string, grace int64) (*APIKey, string, *APIKey, error) {
+	old, err := db.GetAPIKey(id)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	if old.RevokedAt != 0 {
+		return nil, "", nil, ErrRevokedAPIKey
+	}
+	now := time.Now().Unix()
+	if old.ExpiresAt != 0 && old.ExpiresAt <= now {
+		return nil, "", nil, ErrExpiredAPIKey
+	}
+
+	k, key, err := db.CreateAPIKey(old.Role, old.CustomerID, old.Description, old.ExpiresAt)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	if grace > 0 {
+		// the old key never outlives its own expiry
+		until := now + grace
+		if old.ExpiresAt == 0 || until < old.ExpiresAt {
+			_, err = db.Exec("UPDATE api_keys SET expires_at = ? WHERE id = ?", until, id)
+		}
+	} else {
+		_, err = db.Exec("UPDATE api_keys SET revoked_at = ? WHERE id = ?", now, id)
+	}
+	if err != nil {
+		return nil, "", nil, err
+	}
+	old, err = db.GetAPIKey(id)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	return k, key, old, nil
+}
+
+// ValidateAPIKey finds the stored key a presented key belongs to, revoked
+// and expired keys are rejected
+func (db *DatabaseStruct) ValidateAPIKey(key string) (*APIKey, error) {
+	rows, err := db.Query("SELECT "+apiKeyColumns+", salt, hash FROM api_keys WHERE prefix = substr(?, 1, length(prefix))", key)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var k APIKey
+		var customerID, description sql.NullString
+		var revokedAt sql.NullInt64
+		var salt, hash string
+		if err := rows.Scan(&k.ID, &k.Prefix, &k.Role, &customerID, &description, &k.CreatedAt, &k.ExpiresAt, &revokedAt, &salt, &hash); err != nil {
+			return nil, err
+		}
+		if subtle.ConstantTimeCompare([]byte(hashKey(salt, key)), []byte(hash)) != 1 {
+			continue
+		}
+		if revokedAt.Valid {
+			return nil, ErrRevokedAPIKey
+		}
+		if k.ExpiresAt != 0 && k.ExpiresAt <= time.Now().Unix() {
+			return nil, ErrExpiredAPIKey
+		}
+		k.CustomerID = customerID.String
+		k.Description = description.String
+		return &k, nil
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return nil, ErrInvalidAPIKey
+}
+
+// devKeys are the well known keys of a development setup
+var devKeys = []struct {
+	id, key, role, customerID, description string
+}{
+	{"dev-customer", "customer-key-123", "customer", "LAPTOPSTORE001", "Development customer key"},
+	{"dev-admin-1", "admin-key-456", "admin", "", "Development admin key"},
+	{"dev-admin-2", "admin-key-789", "admin", "", "Development admin key"},
+}
+
+// SeedDevData adds the development keys and the customer the customer key
+// belongs to, unless they are already there. Never use it on a real setup,
+// the keys are in the README.
+func (db *DatabaseStruct) SeedDevData() error {
+	_, err := db.Exec(`INSERT OR IGNORE INTO customers (id, name, created_at, updated_at) VALUES ('LAPTOPSTORE001', 'Laptop Store', strftime('%s', 'now'), strftime('%s', 'now'))`)
+	if err != nil {
+		return err
+	}
+	for _, dev := range devKeys {
+		var exists int
+		if err := db.QueryRow("SELECT COUNT(*) FROM api_keys WHERE id = ?", dev.id).Scan(&exists); err != nil {
+			return err
+		}
+		if exists > 0 {
+			continue
+		}
+		k := &APIKey{ID: dev.id, Prefix: legacyPrefix(dev.key), Role: dev.role, CustomerID: dev.customerID,
+			Description: dev.description, CreatedAt: time.Now().Unix()}
+		if err := insertAPIKey(db, k, dev.key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HasAdminKey reports whether any usable admin key exists
+func (db *DatabaseStruct) HasAdminKey() (bool, error) {
+	var n int
+	err := db.QueryRow("SELECT COUNT(*) FROM api_keys WHERE role = 'admin' AND revoked_at IS NULL AND (expires_at = 0 OR expires_at > ?)",
+		time.Now().Unix()).Scan(&n)
+	return n > 0, err
+}
+
+// legacyPrefix is the visible part of a key that wasn't generated here, half
+// of it at most so the prefix never gives the key away
+func legacyPrefix(key string) string {
+	return key[:min(8, len(key)/2)]
+}
+
+// migrateUsers moves the plaintext keys of the old users table into
+// api_keys and points audit logs and orders at the new key ids
+func migrateUsers(db *sql.DB) error {
+	var exists int
+	if err := db.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'users'").Scan(&exists); err != nil {
+		return err
+	}
+	if exists == 0 {
+		return nil
+	}
+	if _, err := addColumn(db, "users", "customer_id", "TEXT"); err != nil {
+		return err
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query("SELECT api_key, role, customer_id FROM users")
+	if err != nil {
+		return err
+	}
+	var keys []*APIKey
+	var plaintext []string
+	for rows.Next() {
+		var key, role string
+		var customerID sql.NullString
+		if err := rows.Scan(&key, &role, &customerID); err != nil {
+			rows.Close()
+			return err
+		}
+		keys = append(keys, &APIKey{ID: uuid.New().String(), Prefix: legacyPrefix(key), Role: role,
+			CustomerID: customerID.String, Description: "Migrated key", CreatedAt: time.Now().Unix()})
+		plaintext = append(plaintext, key)
+	}
+	rows.Close()
+
+	for i, k := range keys {
+		// the development keys keep their well known ids
+		for _, dev := range devKeys {
+			if dev.key == plaintext[i] {
+				k.ID = dev.id
+				k.Description = dev.description
+			}
+		}
+		if err := insertAPIKey(tx, k, plaintext[i]); err != nil {
+			return err
+		}
+		if _, err := tx.Exec("UPDATE audit_logs SET api_key = ? WHERE api_key = ?", k.ID, plaintext[i]); err != nil {
+			return err
+		}
+		if _, err := tx.Exec("UPDATE orders SET created_by = ? WHERE created_by = ?", k.ID, plaintext[i]); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.Exec("DROP TABLE users"); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
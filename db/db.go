@@ -2,7 +2,7 @@ package db
 
 import (
 	"database/sql"
-	"errors"
+	"fmt"
 	"log"
 
 	_ "github.com/mattn/go-sqlite3"
@@ -13,7 +13,9 @@ type DatabaseStruct struct {
 }
 
 func InitDB(dbPath string) (*DatabaseStruct, error) {
-	db, err := sql.Open("sqlite3", dbPath)
+	// immediate transactions serialize writers so stock checks and
+	// reservations can't interleave between two orders
+	db, err := sql.Open("sqlite3", dbPath+"?_txlock=immediate&_busy_timeout=5000")
 	if err != nil {
 		log.Printf("Could not open DB file: %v", err)
 		return nil, err
@@ -28,7 +30,8 @@ func InitDB(dbPath string) (*DatabaseStruct, error) {
 			quantity INTEGER NOT NULL,
 			unit_price_value INTEGER NOT NULL,
 			unit_price_currency TEXT NOT NULL,
-			updated_at INTEGER NOT NULL
+			updated_at INTEGER NOT NULL,
+			tax_category TEXT NOT NULL DEFAULT 'STANDARD'
 		);
 		CREATE TABLE IF NOT EXISTS orders (
 			id TEXT PRIMARY KEY,
@@ -36,12 +39,41 @@ func InitDB(dbPath string) (*DatabaseStruct, error) {
 			total_value INTEGER NOT NULL,
 			total_currency TEXT NOT NULL,
 			status TEXT NOT NULL,
-			created_at INTEGER NOT NULL
+			created_at INTEGER NOT NULL,
+			created_by TEXT,
+			subtotal_value INTEGER,
+			tax_total_value INTEGER,
+			ship_to_region TEXT,
+			tax_jurisdiction TEXT,
+			prices_include_tax INTEGER,
+			discount_total_value INTEGER,
+			ship_to_name TEXT,
+			ship_to_line1 TEXT,
+			ship_to_line2 TEXT,
+			ship_to_city TEXT,
+			ship_to_region_code TEXT,
+			ship_to_postal_code TEXT,
+			ship_to_country TEXT
 		);
 		CREATE TABLE IF NOT EXISTS order_items (
 			order_id TEXT,
 			item_id TEXT,
 			quantity INTEGER NOT NULL,
+			fulfilled_quantity INTEGER NOT NULL DEFAULT 0,
+			unit_price_value INTEGER,
+			unit_price_currency TEXT,
+			item_name TEXT,
+			item_description TEXT,
+			line_total_value INTEGER,
+			original_unit_price_value INTEGER,
+			original_currency TEXT,
+			original_line_total_value INTEGER,
+			exchange_rate TEXT,
+			rate_effective_at INTEGER,
+			tax_value INTEGER,
+			tax_rate TEXT,
+			tax_category TEXT,
+			discount_value INTEGER,
 			PRIMARY KEY (order_id, item_id),
 			FOREIGN KEY (order_id) REFERENCES orders(id),
 			FOREIGN KEY (item_id) REFERENCES items(id)
@@ -54,9 +86,179 @@ func InitDB(dbPath string) (*DatabaseStruct, error) {
 			updated_at INTEGER NOT NULL,
 			FOREIGN KEY (order_id) REFERENCES orders(id)
 		);
-		CREATE TABLE IF NOT EXISTS users (
-			api_key TEXT PRIMARY KEY,
-			role TEXT NOT NULL
+		CREATE TABLE IF NOT EXISTS shipment_items (
+			shipment_id TEXT,
+			item_id TEXT,
+			quantity INTEGER NOT NULL,
+			PRIMARY KEY (shipment_id, item_id),
+			FOREIGN KEY (shipment_id) REFERENCES shipments(id),
+			FOREIGN KEY (item_id) REFERENCES items(id)
+		);
+		CREATE TABLE IF NOT EXISTS shipment_events (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			shipment_id TEXT NOT NULL,
+			from_status TEXT NOT NULL,
+			to_status TEXT NOT NULL,
+			location TEXT,
+			note TEXT,
+			timestamp INTEGER NOT NULL,
+			FOREIGN KEY (shipment_id) REFERENCES shipments(id)
+		);
+		CREATE INDEX IF NOT EXISTS idx_shipment_events_shipment ON shipment_events(shipment_id);
+		CREATE TABLE IF NOT EXISTS reservations (
+			id TEXT PRIMARY KEY,
+			order_id TEXT NOT NULL,
+			item_id TEXT NOT NULL,
+			quantity INTEGER NOT NULL,
+			status TEXT NOT NULL,
+			created_at INTEGER NOT NULL,
+			expires_at INTEGER NOT NULL,
+			FOREIGN KEY (order_id) REFERENCES orders(id),
+			FOREIGN KEY (item_id) REFERENCES items(id)
+		);
+		CREATE INDEX IF NOT EXISTS idx_reservations_item ON reservations(item_id, status);
+		CREATE TABLE IF NOT EXISTS order_events (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			order_id TEXT NOT NULL,
+			type TEXT NOT NULL,
+			from_status TEXT NOT NULL,
+			to_status TEXT NOT NULL,
+			note TEXT,
+			timestamp INTEGER NOT NULL,
+			FOREIGN KEY (order_id) REFERENCES orders(id)
+		);
+		CREATE INDEX IF NOT EXISTS idx_order_events_order ON order_events(order_id);
+		CREATE TABLE IF NOT EXISTS returns (
+			id TEXT PRIMARY KEY,
+			order_id TEXT NOT NULL,
+			status TEXT NOT NULL,
+			reason TEXT,
+			refund_value INTEGER NOT NULL,
+			refund_currency TEXT NOT NULL,
+			created_at INTEGER NOT NULL,
+			updated_at INTEGER NOT NULL,
+			FOREIGN KEY (order_id) REFERENCES orders(id)
+		);
+		CREATE INDEX IF NOT EXISTS idx_returns_order ON returns(order_id);
+		CREATE TABLE IF NOT EXISTS return_items (
+			return_id TEXT,
+			item_id TEXT,
+			quantity INTEGER NOT NULL,
+			reason_code TEXT NOT NULL,
+			condition TEXT,
+			disposition TEXT,
+			location TEXT,
+			refund_value INTEGER NOT NULL,
+			PRIMARY KEY (return_id, item_id),
+			FOREIGN KEY (return_id) REFERENCES returns(id),
+			FOREIGN KEY (item_id) REFERENCES items(id)
+		);
+		CREATE TABLE IF NOT EXISTS quarantine_stock (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			item_id TEXT NOT NULL,
+			return_id TEXT NOT NULL,
+			quantity INTEGER NOT NULL,
+			condition TEXT NOT NULL,
+			location TEXT NOT NULL,
+			received_at INTEGER NOT NULL,
+			FOREIGN KEY (item_id) REFERENCES items(id),
+			FOREIGN KEY (return_id) REFERENCES returns(id)
+		);
+		CREATE TABLE IF NOT EXISTS exchange_rates (
+			base_currency TEXT NOT NULL,
+			quote_currency TEXT NOT NULL,
+			rate TEXT NOT NULL,
+			effective_at INTEGER NOT NULL,
+			created_at INTEGER NOT NULL,
+			PRIMARY KEY (base_currency, quote_currency, effective_at)
+		);
+		CREATE TABLE IF NOT EXISTS promotions (
+			id TEXT PRIMARY KEY,
+			name TEXT NOT NULL,
+			type TEXT NOT NULL,
+			code TEXT,
+			percent_off TEXT,
+			amount_off_value INTEGER,
+			amount_off_currency TEXT,
+			buy_quantity INTEGER NOT NULL DEFAULT 0,
+			get_quantity INTEGER NOT NULL DEFAULT 0,
+			starts_at INTEGER NOT NULL DEFAULT 0,
+			ends_at INTEGER NOT NULL DEFAULT 0,
+			usage_limit INTEGER NOT NULL DEFAULT 0,
+			usage_count INTEGER NOT NULL DEFAULT 0,
+			active INTEGER NOT NULL DEFAULT 1,
+			created_at INTEGER NOT NULL
+		);
+		CREATE UNIQUE INDEX IF NOT EXISTS idx_promotions_code ON promotions(code) WHERE code IS NOT NULL;
+		CREATE TABLE IF NOT EXISTS promotion_items (
+			promotion_id TEXT,
+			item_id TEXT,
+			PRIMARY KEY (promotion_id, item_id),
+			FOREIGN KEY (promotion_id) REFERENCES promotions(id)
+		);
+		CREATE TABLE IF NOT EXISTS promotion_tiers (
+			promotion_id TEXT,
+			min_quantity INTEGER NOT NULL,
+			percent_off TEXT NOT NULL,
+			PRIMARY KEY (promotion_id, min_quantity),
+			FOREIGN KEY (promotion_id) REFERENCES promotions(id)
+		);
+		CREATE TABLE IF NOT EXISTS order_discounts (
+			order_id TEXT,
+			item_id TEXT,
+			promotion_id TEXT,
+			amount_value INTEGER NOT NULL,
+			PRIMARY KEY (order_id, item_id, promotion_id),
+			FOREIGN KEY (order_id) REFERENCES orders(id),
+			FOREIGN KEY (promotion_id) REFERENCES promotions(id)
+		);
+		CREATE TABLE IF NOT EXISTS customers (
+			id TEXT PRIMARY KEY,
+			name TEXT NOT NULL,
+			email TEXT,
+			phone TEXT,
+			created_at INTEGER NOT NULL,
+			updated_at INTEGER NOT NULL
+		);
+		CREATE TABLE IF NOT EXISTS customer_addresses (
+			id TEXT PRIMARY KEY,
+			customer_id TEXT NOT NULL,
+			type TEXT NOT NULL,
+			name TEXT,
+			line1 TEXT NOT NULL,
+			line2 TEXT,
+			city TEXT NOT NULL,
+			region TEXT,
+			postal_code TEXT,
+			country TEXT NOT NULL,
+			created_at INTEGER NOT NULL,
+			FOREIGN KEY (customer_id) REFERENCES customers(id)
+		);
+		CREATE INDEX IF NOT EXISTS idx_customer_addresses_customer ON customer_addresses(customer_id);
+		CREATE TABLE IF NOT EXISTS api_keys (
+			id TEXT PRIMARY KEY,
+			prefix TEXT NOT NULL,
+			salt TEXT NOT NULL,
+			hash TEXT NOT NULL,
+			role TEXT NOT NULL,
+			customer_id TEXT,
+			description TEXT,
+			created_at INTEGER NOT NULL,
+			expires_at INTEGER NOT NULL DEFAULT 0,
+			revoked_at INTEGER,
+			FOREIGN KEY (customer_id) REFERENCES customers(id)
+		);
+		CREATE INDEX IF NOT EXISTS idx_api_keys_prefix ON api_keys(prefix);
+		CREATE TABLE IF NOT EXISTS roles (
+			name TEXT PRIMARY KEY,
+			description TEXT,
+			updated_at INTEGER NOT NULL
+		);
+		CREATE TABLE IF NOT EXISTS role_permissions (
+			role TEXT,
+			permission TEXT,
+			PRIMARY KEY (role, permission),
+			FOREIGN KEY (role) REFERENCES roles(name)
 		);
 		CREATE TABLE IF NOT EXISTS audit_logs (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -65,7 +267,25 @@ func InitDB(dbPath string) (*DatabaseStruct, error) {
 			request_data TEXT NOT NULL,
 			status TEXT NOT NULL,
 			timestamp INTEGER NOT NULL,
-			FOREIGN KEY (api_key) REFERENCES users(api_key)
+			FOREIGN KEY (api_key) REFERENCES api_keys(id)
+		);
+		CREATE TABLE IF NOT EXISTS inventory_events (
+			seq INTEGER PRIMARY KEY AUTOINCREMENT,
+			type INTEGER NOT NULL,
+			item_id TEXT NOT NULL,
+			name TEXT NOT NULL,
+			quantity INTEGER NOT NULL,
+			quantity_delta INTEGER NOT NULL,
+			reason TEXT,
+			occurred_at INTEGER NOT NULL
+		);
+		CREATE INDEX IF NOT EXISTS idx_inventory_events_item ON inventory_events(item_id, seq);
+		CREATE TABLE IF NOT EXISTS quota_usage (
+			caller TEXT NOT NULL,
+			scope TEXT NOT NULL,
+			day TEXT NOT NULL,
+			count INTEGER NOT NULL,
+			PRIMARY KEY (caller, scope, day)
 		);
 	`)
 
@@ -74,31 +294,157 @@ func InitDB(dbPath string) (*DatabaseStruct, error) {
 		return nil, err
 	}
 
-	// insert default users for testing
+	if err := migrate(db); err != nil {
+		log.Println("Error migrating tables")
+		return nil, err
+	}
+
+	return &DatabaseStruct{db}, nil
+}
+
+// ExpireReservations marks active reservations past their expiry as expired
+// so the stock they held becomes available again
+func (db *DatabaseStruct) ExpireReservations(now int64) (int64, error) {
+	result, err := db.Exec("UPDATE reservations SET status = 'EXPIRED' WHERE status = 'ACTIVE' AND expires_at <= ?", now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// PruneInventoryEvents drops inventory events that happened before a time
+func (db *DatabaseStruct) PruneInventoryEvents(before int64) (int64, error) {
+	result, err := db.Exec("DELETE FROM inventory_events WHERE occurred_at < ?", before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// migrate brings tables created by older versions up to the current schema
+func migrate(db *sql.DB) error {
+	added, err := addColumn(db, "order_items", "fulfilled_quantity", "INTEGER NOT NULL DEFAULT 0")
+	if err != nil {
+		return err
+	}
+	if added {
+		// orders fulfilled before partial fulfillment existed shipped every line
+		_, err = db.Exec("UPDATE order_items SET fulfilled_quantity = quantity WHERE order_id IN (SELECT id FROM orders WHERE status = 'FULFILLED')")
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, err := addColumn(db, "orders", "created_by", "TEXT"); err != nil {
+		return err
+	}
+
+	// lines of older orders leave these empty, their prices weren't recorded
+	for _, column := range []struct{ name, definition string }{
+		{"unit_price_value", "INTEGER"},
+		{"unit_price_currency", "TEXT"},
+		{"item_name", "TEXT"},
+		{"item_description", "TEXT"},
+		{"line_total_value", "INTEGER"},
+		// only set on lines converted into a settlement currency
+		{"original_unit_price_value", "INTEGER"},
+		{"original_currency", "TEXT"},
+		{"original_line_total_value", "INTEGER"},
+		{"exchange_rate", "TEXT"},
+		{"rate_effective_at", "INTEGER"},
+		// orders placed before tax was calculated weren't taxed
+		{"tax_value", "INTEGER"},
+		{"tax_rate", "TEXT"},
+		{"tax_category", "TEXT"},
+		{"discount_value", "INTEGER"},
+	} {
+		if _, err := addColumn(db, "order_items", column.name, column.definition); err != nil {
+			return err
+		}
+	}
+	for _, column := range []struct{ name, definition string }{
+		{"subtotal_value", "INTEGER"},
+		{"tax_total_value", "INTEGER"},
+		{"ship_to_region", "TEXT"},
+		{"tax_jurisdiction", "TEXT"},
+		{"prices_include_tax", "INTEGER"},
+		{"discount_total_value", "INTEGER"},
+		// orders placed before customers had addresses don't say where they go
+		{"ship_to_name", "TEXT"},
+		{"ship_to_line1", "TEXT"},
+		{"ship_to_line2", "TEXT"},
+		{"ship_to_city", "TEXT"},
+		{"ship_to_region_code", "TEXT"},
+		{"ship_to_postal_code", "TEXT"},
+		{"ship_to_country", "TEXT"},
+	} {
+		if _, err := addColumn(db, "orders", column.name, column.definition); err != nil {
+			return err
+		}
+	}
+	if _, err := addColumn(db, "items", "tax_category", "TEXT NOT NULL DEFAULT 'STANDARD'"); err != nil {
+		return err
+	}
+	// keys used to be stored in plaintext
+	if err := migrateUsers(db); err != nil {
+		return err
+	}
+
+	// customer ids used to be free text, give each one already on an order a
+	// record so existing orders belong to a customer
 	_, err = db.Exec(`
-		INSERT OR IGNORE INTO USERS (api_key, role) VALUES
-		('customer-key-123', 'customer'),
-		('admin-key-456', 'admin'),
-		('admin-key-789', 'admin')
+		INSERT OR IGNORE INTO customers (id, name, created_at, updated_at)
+		SELECT customer_id, customer_id, MIN(created_at), MIN(created_at) FROM orders GROUP BY customer_id
 	`)
 	if err != nil {
-		log.Println("Error insert default values to users")
-		return nil, err
+		return err
 	}
 
-	return &DatabaseStruct{db}, nil
+	// shipments created before parcels listed their contents carried the whole order
+	_, err = db.Exec(`
+		INSERT INTO shipment_items (shipment_id, item_id, quantity)
+		SELECT s.id, oi.item_id, oi.quantity FROM shipments s
+		JOIN order_items oi ON oi.order_id = s.order_id
+		WHERE s.id = (SELECT MIN(id) FROM shipments WHERE order_id = s.order_id)
+		AND NOT EXISTS (SELECT 1 FROM shipment_items si JOIN shipments s2 ON s2.id = si.shipment_id WHERE s2.order_id = s.order_id)
+	`)
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec(`
+		CREATE INDEX IF NOT EXISTS idx_orders_customer ON orders(customer_id, created_at);
+		CREATE INDEX IF NOT EXISTS idx_orders_created ON orders(created_at);
+	`)
+	return err
 }
 
-func (db *DatabaseStruct) ValidateAPIKey(apiKey string) (string, error) {
-	var role string
-	err := db.QueryRow("SELECT role FROM users WHERE api_key = ?", apiKey).Scan(&role)
-	if err == sql.ErrNoRows {
-		return "", errors.New("Invalid API Key")
+// addColumn adds a column to a table unless it already has it, it reports
+// whether the column was added
+func addColumn(db *sql.DB, table, column, definition string) (bool, error) {
+	rows, err := db.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return false, err
 	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cid, notNull, pk int
+		var name, colType string
+		var defaultValue sql.NullString
+		if err := rows.Scan(&cid, &name, &colType, &notNull, &defaultValue, &pk); err != nil {
+			return false, err
+		}
+		if name == column {
+			return false, nil
+		}
+	}
+	rows.Close()
+
+	_, err = db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition))
 	if err != nil {
-		return "", err
+		return false, err
 	}
-	return role, nil
+	return true, nil
 }
 
 // AuditLog represents an audit log entry
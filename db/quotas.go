@@ -0,0 +1,27 @@
+package db
+
+// UseQuota counts a call against a caller's daily quota. It returns false
+// without counting the call when the quota is used up.
+func (db *DatabaseStruct) UseQuota(caller, scope, day string, quota int64) (bool, error) {
+	result, err := db.Exec(`
+		INSERT INTO quota_usage (caller, scope, day, count) VALUES (?, ?, ?, 1)
+		ON CONFLICT(caller, scope, day) DO UPDATE SET count = count + 1 WHERE count < ?`,
+		caller, scope, day, quota)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// PruneQuotaUsage drops the counts of days before a day
+func (db *DatabaseStruct) PruneQuotaUsage(before string) (int64, error) {
+	result, err := db.Exec("DELETE FROM quota_usage WHERE day < ?", before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
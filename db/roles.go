@@ -0,0 +1,137 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Scrimzay/supplychain/policy"
+)
+
+var (
+	ErrRoleNotFound = errors.New("role not found")
+	ErrRoleInUse    = errors.New("role is used by API keys")
+)
+
+// ListRoles returns every stored role with its permissions
+func (db *DatabaseStruct) ListRoles() ([]*policy.Role, error) {
+	rows, err := db.Query("SELECT name, COALESCE(description, '') FROM roles ORDER BY name")
+	if err != nil {
+		return nil, err
+	}
+	var roles []*policy.Role
+	byName := map[string]*policy.Role{}
+	for rows.Next() {
+		role := &policy.Role{Permissions: []string{}}
+		if err := rows.Scan(&role.Name, &role.Description); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		roles = append(roles, role)
+		byName[role.Name] = role
+	}
+	rows.Close()
+
+	rows, err = db.Query("SELECT role, permission FROM role_permissions ORDER BY role, permission")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name, permission string
+		if err := rows.Scan(&name, &permission); err != nil {
+			return nil, err
+		}
+		if role, ok := byName[name]; ok {
+			role.Permissions = append(role.Permissions, permission)
+		}
+	}
+	return roles, rows.Err()
+}
+
+// putRole creates a role or replaces its description and permissions
+func putRole(tx *sql.Tx, role *policy.Role) error {
+	_, err := tx.Exec(`
+		INSERT INTO roles (name, description, updated_at) VALUES (?, ?, ?)
+		ON CONFLICT(name) DO UPDATE SET description = excluded.description, updated_at = excluded.updated_at`,
+		role.Name, role.Description, time.Now().Unix())
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM role_permissions WHERE role = ?", role.Name); err != nil {
+		return err
+	}
+	for _, permission := range role.Permissions {
+		if _, err := tx.Exec("INSERT OR IGNORE INTO role_permissions (role, permission) VALUES (?, ?)", role.Name, permission); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PutRole creates a role or replaces its description and permissions
+func (db *DatabaseStruct) PutRole(role *policy.Role) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := putRole(tx, role); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// DeleteRole removes a role no usable API key has
+func (db *DatabaseStruct) DeleteRole(name string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var keys int
+	err = tx.QueryRow("SELECT COUNT(*) FROM api_keys WHERE role = ? AND revoked_at IS NULL AND (expires_at = 0 OR expires_at > ?)",
+		name, time.Now().Unix()).Scan(&keys)
+	if err != nil {
+		return err
+	}
+	if keys > 0 {
+		return ErrRoleInUse
+	}
+	if _, err := tx.Exec("DELETE FROM role_permissions WHERE role = ?", name); err != nil {
+		return err
+	}
+	result, err := tx.Exec("DELETE FROM roles WHERE name = ?", name)
+	if err != nil {
+		return err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrRoleNotFound
+	}
+	return tx.Commit()
+}
+
+// SeedRoles stores a set of roles when there are none yet
+func (db *DatabaseStruct) SeedRoles(roles []*policy.Role) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var n int
+	if err := tx.QueryRow("SELECT COUNT(*) FROM roles").Scan(&n); err != nil {
+		return err
+	}
+	if n > 0 {
+		return nil
+	}
+	for _, role := range roles {
+		if err := putRole(tx, role); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Scrimzay/supplychain/supplychain"
+)
+
+// fulfillOrder takes an order's outstanding lines out of inventory. In
+// all-or-nothing mode any short line fails the whole order, in partial mode
+// whatever is on hand is fulfilled and the rest is backordered.
+func (s *SupplyChainServer) fulfillOrder(ctx context.Context, orderID string, mode supplychain.FulfillmentMode) (*supplychain.Order, []*supplychain.StockShortfall, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	var current string
+	err = tx.QueryRowContext(ctx, "SELECT status FROM orders WHERE id = ?", orderID).Scan(&current)
+	if err == sql.ErrNoRows {
+		return nil, nil, status.Error(codes.NotFound, "Order not found")
+	}
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, "Failed to check order")
+	}
+	if !canTransitionOrder(current, orderFulfilled) {
+		return nil, nil, orderTransitionError(current, orderFulfilled)
+	}
+
+	rows, err := tx.QueryContext(ctx, "SELECT item_id, quantity, fulfilled_quantity FROM order_items WHERE order_id = ?", orderID)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, "Failed to fetch order items")
+	}
+	var lines []*supplychain.OrderItem
+	for rows.Next() {
+		var line supplychain.OrderItem
+		if err := rows.Scan(&line.ItemId, &line.Quantity, &line.FulfilledQuantity); err != nil {
+			rows.Close()
+			return nil, nil, status.Error(codes.Internal, "Failed to scan order items")
+		}
+		lines = append(lines, &line)
+	}
+	rows.Close()
+
+	// work out what every line can take before touching inventory so a short
+	// order fails as a whole, stock held for other orders doesn't count
+	now := time.Now().Unix()
+	take := make([]int32, len(lines))
+	var shortfalls []*supplychain.StockShortfall
+	for i, line := range lines {
+		outstanding := line.Quantity - line.FulfilledQuantity
+		if outstanding <= 0 {
+			continue
+		}
+		var onHand, reservedByOthers int32
+		err := tx.QueryRowContext(ctx,
+			"SELECT quantity, "+reservedByOthersColumn+" FROM items WHERE id = ?",
+			now, orderID, line.ItemId).Scan(&onHand, &reservedByOthers)
+		if err == sql.ErrNoRows {
+			onHand, reservedByOthers = 0, 0
+		} else if err != nil {
+			return nil, nil, status.Error(codes.Internal, "Failed to check inventory")
+		}
+		available := max(onHand-reservedByOthers, 0)
+		take[i] = min(outstanding, available)
+		if available < outstanding {
+			shortfalls = append(shortfalls, &supplychain.StockShortfall{
+				ItemId:    line.ItemId,
+				Requested: outstanding,
+				Available: available,
+			})
+		}
+	}
+	if len(shortfalls) > 0 && mode != supplychain.FulfillmentMode_FULFILLMENT_MODE_PARTIAL {
+		return nil, nil, insufficientStockError("Insufficient stock to fulfill order", shortfalls)
+	}
+
+	var taken, fulfilled int
+	for i, line := range lines {
+		if take[i] > 0 {
+			result, err := tx.ExecContext(ctx, "UPDATE items SET quantity = quantity - ? WHERE id = ? AND quantity >= ?", take[i], line.ItemId, take[i])
+			if err != nil {
+				return nil, nil, status.Error(codes.Internal, "Failed to update inventory")
+			}
+			if n, _ := result.RowsAffected(); n != 1 {
+				return nil, nil, status.Error(codes.Aborted, "Inventory changed during fulfillment")
+			}
+			_, err = tx.ExecContext(ctx,
+				"UPDATE order_items SET fulfilled_quantity = fulfilled_quantity + ? WHERE order_id = ? AND item_id = ?",
+				take[i], orderID, line.ItemId)
+			if err != nil {
+				return nil, nil, status.Error(codes.Internal, "Failed to update order items")
+			}
+			if err := consumeReservation(ctx, tx, orderID, line.ItemId, take[i]); err != nil {
+				return nil, nil, status.Error(codes.Internal, "Failed to consume reservations")
+			}
+			if err := recordStockChange(ctx, tx, line.ItemId, -take[i], "FulfillOrder "+orderID, now); err != nil {
+				return nil, nil, status.Error(codes.Internal, "Failed to record inventory event")
+			}
+			line.FulfilledQuantity += take[i]
+			taken++
+		}
+		if line.FulfilledQuantity >= line.Quantity {
+			fulfilled++
+		}
+	}
+
+	next := orderBackordered
+	switch {
+	case fulfilled == len(lines):
+		next = orderFulfilled
+	case fulfilled > 0 || taken > 0 || current == orderPartiallyFulfilled:
+		next = orderPartiallyFulfilled
+	}
+	// a backordered order that still can't take anything stays as it is
+	if taken > 0 || next != current {
+		if err := transitionOrder(ctx, tx, orderID, current, next, ""); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	order, err := loadOrder(ctx, tx, orderID)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, "Failed to fetch order")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, status.Error(codes.Internal, "Failed to commit transaction")
+	}
+	s.orderUpdates.notify()
+	if taken > 0 {
+		s.inventory.notify()
+	}
+
+	return order, shortfalls, nil
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Scrimzay/supplychain/money"
+	"github.com/Scrimzay/supplychain/supplychain"
+)
+
+var errNoExchangeRate = errors.New("no exchange rate")
+
+// lookupRate finds the rate in effect at a unix time for converting from one
+// currency into another. A pair only loaded the other way round is inverted,
+// the inverse is cut to the precision rates are stored with so the rate
+// recorded on an order line is exactly the one that was applied.
+func lookupRate(ctx context.Context, q queryer, from, to string, at int64) (*big.Rat, int64, error) {
+	var rate string
+	var effectiveAt int64
+	err := q.QueryRowContext(ctx,
+		"SELECT rate, effective_at FROM exchange_rates WHERE base_currency = ? AND quote_currency = ? AND effective_at <= ? ORDER BY effective_at DESC LIMIT 1",
+		from, to, at).Scan(&rate, &effectiveAt)
+	if err == nil {
+		r, err := money.ParseRate(rate)
+		return r, effectiveAt, err
+	}
+	if err != sql.ErrNoRows {
+		return nil, 0, err
+	}
+
+	err = q.QueryRowContext(ctx,
+		"SELECT rate, effective_at FROM exchange_rates WHERE base_currency = ? AND quote_currency = ? AND effective_at <= ? ORDER BY effective_at DESC LIMIT 1",
+		to, from, at).Scan(&rate, &effectiveAt)
+	if err == sql.ErrNoRows {
+		return nil, 0, errNoExchangeRate
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	r, err := money.ParseRate(rate)
+	if err != nil {
+		return nil, 0, err
+	}
+	inverse, err := money.ParseRate(money.FormatRate(new(big.Rat).Inv(r)))
+	return inverse, effectiveAt, err
+}
+
+// convertLine reprices an order line into the settlement currency at the rate
+// in effect at a unix time, keeping the original prices and the rate on the line
+func convertLine(ctx context.Context, q queryer, line *supplychain.OrderItem, settlement string, at int64) error {
+	from := line.UnitPrice.Currency
+	if from == settlement {
+		return nil
+	}
+	rate, effectiveAt, err := lookupRate(ctx, q, from, settlement, at)
+	if err == errNoExchangeRate {
+		return status.Errorf(codes.FailedPrecondition, "No exchange rate from %s to %s", from, settlement)
+	}
+	if err != nil {
+		return status.Error(codes.Internal, "Failed to look up exchange rate")
+	}
+	converted, err := money.Convert(line.UnitPrice.Value, from, settlement, rate)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Cannot convert %s to %s", from, settlement)
+	}
+
+	line.OriginalUnitPrice = &supplychain.Amount{Value: line.UnitPrice.Value, Currency: from}
+	line.OriginalLineTotal = &supplychain.Amount{Value: line.UnitPrice.Value * int64(line.Quantity), Currency: from}
+	line.ExchangeRate = money.FormatRate(rate)
+	line.RateEffectiveAt = effectiveAt
+	line.UnitPrice = &supplychain.Amount{Value: converted, Currency: settlement}
+	return nil
+}
+
+// conversionColumns returns the original price and exchange rate columns of
+// an order_items row, all NULL for lines sold in their own currency
+func conversionColumns(line *supplychain.OrderItem) []interface{} {
+	if line.OriginalUnitPrice == nil {
+		return []interface{}{nil, nil, nil, nil, nil}
+	}
+	return []interface{}{line.OriginalUnitPrice.Value, line.OriginalUnitPrice.Currency, line.OriginalLineTotal.Value, line.ExchangeRate, line.RateEffectiveAt}
+}
+
+// addRates validates and stores exchange rates, a rate for a pair and
+// effective time that already exists is replaced
+func addRates(ctx context.Context, tx *sql.Tx, rates []*supplychain.ExchangeRate) error {
+	now := time.Now().Unix()
+	for i, rate := range rates {
+		if !money.Valid(rate.BaseCurrency) || !money.Valid(rate.QuoteCurrency) {
+			return status.Errorf(codes.InvalidArgument, "Rate %d: unknown currency pair %s/%s", i+1, rate.BaseCurrency, rate.QuoteCurrency)
+		}
+		if rate.BaseCurrency == rate.QuoteCurrency {
+			return status.Errorf(codes.InvalidArgument, "Rate %d: base and quote currency are both %s", i+1, rate.BaseCurrency)
+		}
+		r, err := money.ParseRate(rate.Rate)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "Rate %d: invalid rate %q", i+1, rate.Rate)
+		}
+		if rate.EffectiveAt <= 0 {
+			return status.Errorf(codes.InvalidArgument, "Rate %d: effective time required", i+1)
+		}
+
+		_, err = tx.ExecContext(ctx,
+			"INSERT OR REPLACE INTO exchange_rates (base_currency, quote_currency, rate, effective_at, created_at) VALUES (?, ?, ?, ?, ?)",
+			rate.BaseCurrency, rate.QuoteCurrency, money.FormatRate(r), rate.EffectiveAt, now)
+		if err != nil {
+			return status.Error(codes.Internal, "Failed to store exchange rate")
+		}
+	}
+	return nil
+}
+
+// parseRatesCSV reads base,quote,rate,effective_at rows, a header row is
+// skipped. effective_at may be RFC3339, a YYYY-MM-DD date (midnight UTC) or unix seconds.
+func parseRatesCSV(data []byte) ([]*supplychain.ExchangeRate, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = 4
+	r.TrimLeadingSpace = true
+	r.Comment = '#'
+
+	var rates []*supplychain.ExchangeRate
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := r.FieldPos(0)
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "base") {
+			continue
+		}
+		effectiveAt, err := parseEffectiveAt(strings.TrimSpace(record[3]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid effective_at %q", line, record[3])
+		}
+		rates = append(rates, &supplychain.ExchangeRate{
+			BaseCurrency:  strings.ToUpper(strings.TrimSpace(record[0])),
+			QuoteCurrency: strings.ToUpper(strings.TrimSpace(record[1])),
+			Rate:          strings.TrimSpace(record[2]),
+			EffectiveAt:   effectiveAt,
+		})
+	}
+	return rates, nil
+}
+
+// parseEffectiveAt reads the effective_at column of a rates file
+func parseEffectiveAt(s string) (int64, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.Unix(), nil
+	}
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t.Unix(), nil
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
+
+func (s *SupplyChainServer) AddExchangeRates(ctx context.Context, req *supplychain.AddExchangeRatesRequest) (*supplychain.AddExchangeRatesResponse, error) {
+	if len(req.Rates) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No rates given")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	if err := addRates(ctx, tx, req.Rates); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to commit transaction")
+	}
+
+	return &supplychain.AddExchangeRatesResponse{Added: int32(len(req.Rates))}, nil
+}
+
+func (s *SupplyChainServer) ImportExchangeRates(ctx context.Context, req *supplychain.ImportExchangeRatesRequest) (*supplychain.ImportExchangeRatesResponse, error) {
+	rates, err := parseRatesCSV(req.Csv)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid rates file: %v", err)
+	}
+	if len(rates) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No rates given")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	// the whole file goes in or nothing does
+	if err := addRates(ctx, tx, rates); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to commit transaction")
+	}
+
+	return &supplychain.ImportExchangeRatesResponse{Imported: int32(len(rates))}, nil
+}
+
+func (s *SupplyChainServer) ListExchangeRates(ctx context.Context, req *supplychain.ListExchangeRatesRequest) (*supplychain.ListExchangeRatesResponse, error) {
+	query := "SELECT base_currency, quote_currency, rate, effective_at FROM exchange_rates r WHERE 1 = 1"
+	args := []interface{}{}
+	if req.BaseCurrency != "" {
+		query += " AND base_currency = ?"
+		args = append(args, req.BaseCurrency)
+	}
+	if req.QuoteCurrency != "" {
+		query += " AND quote_currency = ?"
+		args = append(args, req.QuoteCurrency)
+	}
+	if req.AsOf != 0 {
+		query += ` AND effective_at = (SELECT MAX(effective_at) FROM exchange_rates
+			WHERE base_currency = r.base_currency AND quote_currency = r.quote_currency AND effective_at <= ?)`
+		args = append(args, req.AsOf)
+	}
+	query += " ORDER BY base_currency, quote_currency, effective_at"
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to list exchange rates")
+	}
+	defer rows.Close()
+
+	resp := &supplychain.ListExchangeRatesResponse{}
+	for rows.Next() {
+		var rate supplychain.ExchangeRate
+		if err := rows.Scan(&rate.BaseCurrency, &rate.QuoteCurrency, &rate.Rate, &rate.EffectiveAt); err != nil {
+			return nil, status.Error(codes.Internal, "Failed to scan exchange rates")
+		}
+		resp.Rates = append(resp.Rates, &rate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to list exchange rates")
+	}
+
+	return resp, nil
+}
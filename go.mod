@@ -3,6 +3,7 @@ module github.com/Scrimzay/supplychain
 go 1.24.1
 
 require (
+	github.com/golang-jwt/jwt/v5 v5.2.2
 	github.com/google/uuid v1.6.0
 	github.com/mattn/go-sqlite3 v1.14.28
 	google.golang.org/grpc v1.72.0
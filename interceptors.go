@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/Scrimzay/supplychain/auth"
+	"github.com/Scrimzay/supplychain/db"
+	"github.com/Scrimzay/supplychain/policy"
+)
+
+// gate is what every call passes through, unary or streaming: the caller
+// is authenticated, checked against the policy and rate limits, and the
+// call is audited
+type gate struct {
+	db            *db.DatabaseStruct
+	authenticator auth.Authenticator
+	policies      *policy.Holder
+	limits        *rateLimits
+}
+
+// admit checks a call and returns its context with the caller in it. A call
+// turned away by the rate limits is audited with the request it carried.
+func (g *gate) admit(ctx context.Context, method string, req interface{}) (context.Context, *principal, error) {
+	// extract credentials from metadata
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil, status.Error(codes.Unauthenticated, "No metadata provided")
+	}
+
+	// validate the api key, bearer token or client certificate
+	caller, err := g.authenticator.Authenticate(ctx, md)
+	if err != nil {
+		return nil, nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	p := &principal{KeyID: caller.ID, Role: caller.Role, CustomerID: caller.CustomerID}
+	ctx = context.WithValue(ctx, principalKey{}, p)
+
+	// check the role may use the method
+	permission, known := methodPermissions[method]
+	allowed := known && g.policies.Load().Allows(p.Role, permission)
+	if !allowed {
+		return nil, nil, status.Error(codes.PermissionDenied, "Method not allowed for role")
+	}
+
+	// calls over the limit are logged too so breaches show up
+	if err := g.limits.check(ctx, p, method); err != nil {
+		g.audit(ctx, p.KeyID, method, auditRecord(req, nil), err)
+		return nil, nil, err
+	}
+
+	return ctx, p, nil
+}
+
+func (g *gate) unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, p, err := g.admit(ctx, info.FullMethod, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// call the handler
+	resp, err := handler(ctx, req)
+
+	// log the request, a batch gets one record summing it up
+	g.audit(ctx, p.KeyID, info.FullMethod, auditRecord(req, resp), err)
+
+	return resp, err
+}
+
+// streamEvent is the request data audited when a stream opens or closes
+type streamEvent struct {
+	Event      string `json:"event"`
+	Received   int64  `json:"received,omitempty"`
+	Sent       int64  `json:"sent,omitempty"`
+	DurationMs int64  `json:"duration_ms,omitempty"`
+}
+
+func (g *gate) stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	opened := streamEvent{Event: "open"}
+	ctx, p, err := g.admit(ss.Context(), info.FullMethod, opened)
+	if err != nil {
+		return err
+	}
+	g.auditStatus(ctx, p.KeyID, info.FullMethod, opened, "open")
+
+	start := time.Now()
+	wrapped := &countingStream{ServerStream: ss, ctx: ctx}
+	err = handler(srv, wrapped)
+
+	// the stream's own context is done by now, audit on a fresh one
+	g.audit(context.Background(), p.KeyID, info.FullMethod, streamEvent{
+		Event:      "close",
+		Received:   wrapped.received.Load(),
+		Sent:       wrapped.sent.Load(),
+		DurationMs: time.Since(start).Milliseconds(),
+	}, err)
+
+	return err
+}
+
+// countingStream hands the handler the context holding the caller and
+// counts the messages each way
+type countingStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	received atomic.Int64
+	sent     atomic.Int64
+}
+
+func (s *countingStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *countingStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.received.Add(1)
+	}
+	return err
+}
+
+func (s *countingStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.sent.Add(1)
+	}
+	return err
+}
+
+// audit records a finished call in the audit log
+func (g *gate) audit(ctx context.Context, callerID, method string, req interface{}, err error) {
+	logStatus := "success"
+	if err != nil {
+		logStatus = status.Code(err).String()
+	}
+	g.auditStatus(ctx, callerID, method, req, logStatus)
+}
+
+func (g *gate) auditStatus(ctx context.Context, callerID, method string, req interface{}, logStatus string) {
+	// serialize request to json
+	requestData, err := json.Marshal(req)
+	if err != nil {
+		log.Printf("Failed to serialize request: %v", err)
+		requestData = []byte("{}")
+	}
+
+	_, err = g.db.ExecContext(ctx,
+		"INSERT INTO audit_logs (api_key, method, request_data, status, timestamp) VALUES (?, ?, ?, ?, ?)",
+		callerID, method, string(requestData), logStatus, time.Now().Unix())
+	if err != nil {
+		log.Printf("Failed to save audit log: %v", err)
+	}
+}
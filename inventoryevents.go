@@ -0,0 +1,184 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Scrimzay/supplychain/db"
+	"github.com/Scrimzay/supplychain/supplychain"
+)
+
+const (
+	// inventoryEventDays is how long inventory events can be replayed
+	inventoryEventDays = 7
+	// inventoryEventBatch is how many events a stream reads at a time
+	inventoryEventBatch = 100
+)
+
+// inventoryToken is the cursor behind WatchInventory resume tokens
+type inventoryToken struct {
+	Seq int64 `json:"seq"`
+}
+
+var errInvalidResumeToken = errors.New("invalid resume token")
+
+// encode turns the cursor into an opaque string for clients
+func (t inventoryToken) encode() string {
+	data, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeInventoryToken parses a token handed out by encode
+func decodeInventoryToken(token string) (inventoryToken, error) {
+	var t inventoryToken
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return t, errInvalidResumeToken
+	}
+	if err := json.Unmarshal(data, &t); err != nil || t.Seq <= 0 {
+		return t, errInvalidResumeToken
+	}
+	return t, nil
+}
+
+// recordInventoryEvent adds an event for an item to the log in the same
+// transaction as the change itself
+func recordInventoryEvent(ctx context.Context, tx *sql.Tx, eventType supplychain.InventoryEventType, itemID, name string, quantity, delta int32, reason string, now int64) error {
+	_, err := tx.ExecContext(ctx,
+		"INSERT INTO inventory_events (type, item_id, name, quantity, quantity_delta, reason, occurred_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		int32(eventType), itemID, name, quantity, delta, reason, now)
+	return err
+}
+
+// recordStockChange logs a change to an item's on hand quantity that was
+// just made, items that no longer exist are skipped
+func recordStockChange(ctx context.Context, tx *sql.Tx, itemID string, delta int32, reason string, now int64) error {
+	var name string
+	var quantity int32
+	err := tx.QueryRowContext(ctx, "SELECT name, quantity FROM items WHERE id = ?", itemID).Scan(&name, &quantity)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return recordInventoryEvent(ctx, tx, supplychain.InventoryEventType_INVENTORY_EVENT_QUANTITY_CHANGED, itemID, name, quantity, delta, reason, now)
+}
+
+// loadInventoryEvents returns the events after a sequence number that match
+// a watch request, with the sequence number of each
+func loadInventoryEvents(ctx context.Context, q queryer, after int64, req *supplychain.WatchInventoryRequest) ([]*supplychain.InventoryEvent, []int64, error) {
+	query := "SELECT seq, type, item_id, name, quantity, quantity_delta, COALESCE(reason, ''), occurred_at FROM inventory_events WHERE seq > ?"
+	args := []interface{}{after}
+	if len(req.ItemIds) > 0 {
+		query += " AND item_id IN (?" + strings.Repeat(", ?", len(req.ItemIds)-1) + ")"
+		for _, id := range req.ItemIds {
+			args = append(args, id)
+		}
+	}
+	if req.NameFilter != "" {
+		query += " AND name LIKE ?"
+		args = append(args, "%"+req.NameFilter+"%")
+	}
+	query += " ORDER BY seq LIMIT ?"
+	args = append(args, inventoryEventBatch)
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var events []*supplychain.InventoryEvent
+	var seqs []int64
+	for rows.Next() {
+		var seq int64
+		var eventType int32
+		event := &supplychain.InventoryEvent{}
+		if err := rows.Scan(&seq, &eventType, &event.ItemId, &event.Name, &event.Quantity, &event.QuantityDelta, &event.Reason, &event.OccurredAt); err != nil {
+			return nil, nil, err
+		}
+		event.Type = supplychain.InventoryEventType(eventType)
+		event.ResumeToken = inventoryToken{Seq: seq}.encode()
+		events = append(events, event)
+		seqs = append(seqs, seq)
+	}
+	return events, seqs, rows.Err()
+}
+
+// WatchInventory streams changes to items as they happen. With a resume
+// token the events after it are replayed first.
+func (s *SupplyChainServer) WatchInventory(req *supplychain.WatchInventoryRequest, stream grpc.ServerStreamingServer[supplychain.InventoryEvent]) error {
+	ctx := stream.Context()
+
+	// subscribe before finding the starting point so no change slips
+	// through in between
+	wake, cancel := s.inventory.subscribe()
+	defer cancel()
+
+	var after int64
+	if req.ResumeToken != "" {
+		token, err := decodeInventoryToken(req.ResumeToken)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "Invalid resume token")
+		}
+		// the oldest event still kept, or the next one if all were pruned
+		var oldest int64
+		err = s.db.QueryRowContext(ctx, `
+			SELECT COALESCE((SELECT MIN(seq) FROM inventory_events),
+				(SELECT seq + 1 FROM sqlite_sequence WHERE name = 'inventory_events'), 1)`).Scan(&oldest)
+		if err != nil {
+			return status.Error(codes.Internal, "Failed to check inventory events")
+		}
+		if oldest > token.Seq+1 {
+			return status.Error(codes.OutOfRange, "Resume token has expired, reload the items with ListItems")
+		}
+		after = token.Seq
+	} else {
+		if err := s.db.QueryRowContext(ctx, "SELECT COALESCE(MAX(seq), 0) FROM inventory_events").Scan(&after); err != nil {
+			return status.Error(codes.Internal, "Failed to check inventory events")
+		}
+	}
+
+	return follow(ctx, wake, func() (bool, error) {
+		for {
+			events, seqs, err := loadInventoryEvents(ctx, s.db, after, req)
+			if err != nil {
+				return false, status.Error(codes.Internal, "Failed to fetch inventory events")
+			}
+			for i, event := range events {
+				if err := stream.Send(event); err != nil {
+					return false, err
+				}
+				after = seqs[i]
+			}
+			// a full batch means there is more to catch up on, anything less
+			// means the stream is up to date
+			if len(events) < inventoryEventBatch {
+				return false, nil
+			}
+		}
+	})
+}
+
+// pruneInventoryEvents periodically drops events too old to replay
+func pruneInventoryEvents(db *db.DatabaseStruct, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		before := time.Now().AddDate(0, 0, -inventoryEventDays).Unix()
+		if _, err := db.PruneInventoryEvents(before); err != nil {
+			log.Printf("Failed to prune inventory events: %v", err)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Scrimzay/supplychain/money"
+	"github.com/Scrimzay/supplychain/supplychain"
+)
+
+// importBatch is how many rows an item import writes per transaction
+const importBatch = 500
+
+// importRow is a valid row of an import waiting to be written
+type importRow struct {
+	row  int32
+	item *supplychain.ImportItemsRequest
+}
+
+// checkImportRow returns why a row can't be imported, or "" when it can
+func checkImportRow(item *supplychain.ImportItemsRequest) string {
+	switch {
+	case item.Name == "":
+		return "Name required"
+	case item.Quantity < 0:
+		return "Quantity can't be negative"
+	case item.UnitPrice == nil:
+		return "Unit price required"
+	case item.UnitPrice.Value < 0:
+		return "Unit price can't be negative"
+	case !money.Valid(item.UnitPrice.Currency):
+		return fmt.Sprintf("Unknown currency code %q", item.UnitPrice.Currency)
+	}
+	return ""
+}
+
+// ImportItems creates or updates an item for every row streamed in. Rows
+// that don't validate are rejected and reported, the rest are written in
+// batches of importBatch, each in its own transaction.
+func (s *SupplyChainServer) ImportItems(stream grpc.ClientStreamingServer[supplychain.ImportItemsRequest, supplychain.ImportItemsResponse]) error {
+	ctx := stream.Context()
+	resp := &supplychain.ImportItemsResponse{}
+	// ids created by earlier rows, a dry run never writes them so they
+	// can't be looked up
+	created := map[string]bool{}
+
+	var rows []importRow
+	write := func() error {
+		if len(rows) == 0 {
+			return nil
+		}
+		if err := s.importItems(ctx, rows, resp, created); err != nil {
+			if resp.DryRun {
+				return status.Errorf(codes.Internal, "Failed to check items from row %d on", rows[0].row)
+			}
+			return status.Errorf(codes.Internal, "Failed to import items from row %d on, the rows before it were imported", rows[0].row)
+		}
+		rows = rows[:0]
+		return nil
+	}
+
+	var row int32
+	for {
+		item, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		row++
+		if row == 1 {
+			resp.DryRun = item.DryRun
+		}
+
+		if reason := checkImportRow(item); reason != "" {
+			resp.Rejected++
+			resp.Errors = append(resp.Errors, &supplychain.ImportItemError{Row: row, Id: item.Id, Reason: reason})
+			continue
+		}
+		rows = append(rows, importRow{row: row, item: item})
+		if len(rows) == importBatch {
+			if err := write(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := write(); err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(resp)
+}
+
+// importItems writes a batch of rows in one transaction and adds them to the
+// counts once it commits. A dry run rolls the transaction back instead.
+func (s *SupplyChainServer) importItems(ctx context.Context, rows []importRow, resp *supplychain.ImportItemsResponse, created map[string]bool) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	now := time.Now().Unix()
+	var inserted, updated []string
+	for _, r := range rows {
+		item := r.item
+		id := item.Id
+		if id == "" {
+			id = uuid.New().String()
+		}
+		category := taxCategory(item.TaxCategory)
+
+		var previous int32
+		err := tx.QueryRowContext(ctx, "SELECT quantity FROM items WHERE id = ?", id).Scan(&previous)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+
+		if err == sql.ErrNoRows && !created[id] {
+			_, err = tx.ExecContext(ctx,
+				"INSERT INTO items (id, name, description, quantity, unit_price_value, unit_price_currency, updated_at, tax_category) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+				id, item.Name, item.Description, item.Quantity, item.UnitPrice.Value, item.UnitPrice.Currency, now, category)
+			if err != nil {
+				return err
+			}
+			err = recordInventoryEvent(ctx, tx, supplychain.InventoryEventType_INVENTORY_EVENT_ITEM_CREATED,
+				id, item.Name, item.Quantity, item.Quantity, "ImportItems", now)
+			inserted = append(inserted, id)
+		} else {
+			_, err = tx.ExecContext(ctx,
+				"UPDATE items SET name = ?, description = ?, quantity = ?, unit_price_value = ?, unit_price_currency = ?, updated_at = ?, tax_category = ? WHERE id = ?",
+				item.Name, item.Description, item.Quantity, item.UnitPrice.Value, item.UnitPrice.Currency, now, category, id)
+			if err != nil {
+				return err
+			}
+			err = recordInventoryEvent(ctx, tx, supplychain.InventoryEventType_INVENTORY_EVENT_ITEM_UPDATED,
+				id, item.Name, item.Quantity, item.Quantity-previous, "ImportItems", now)
+			updated = append(updated, id)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if !resp.DryRun {
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+		s.inventory.notify()
+	}
+
+	for _, id := range inserted {
+		created[id] = true
+	}
+	resp.Created += int32(len(inserted))
+	resp.Updated += int32(len(updated))
+	return nil
+}
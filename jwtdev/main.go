@@ -0,0 +1,122 @@
+// jwtdev makes a local signing key and mints tokens with it, for trying
+// out bearer auth without an identity provider
+//
+//	go run ./jwtdev -genkey                    (writes jwtdev-key.pem and jwks.json)
+//	go run ./jwtdev -sub ci -role admin        (prints a token)
+//	go run ./jwtdev -sub shop -role customer -customer LAPTOPSTORE001
+package main
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func main() {
+	genKey := flag.Bool("genkey", false, "Generate a signing key and its JWKS")
+	keyFile := flag.String("keyfile", "jwtdev-key.pem", "Private key file")
+	jwksFile := flag.String("jwksfile", "jwks.json", "JWKS file written by -genkey, pass it to the server with -jwks")
+	subject := flag.String("sub", "", "Subject of the token")
+	role := flag.String("role", "", "Role claim")
+	customer := flag.String("customer", "", "Customer ID claim")
+	issuer := flag.String("iss", "", "Issuer claim")
+	audience := flag.String("aud", "", "Audience claim")
+	ttl := flag.Duration("ttl", 15*time.Minute, "How long the token is valid, negative for an expired one")
+	flag.Parse()
+
+	if *genKey {
+		if err := generate(*keyFile, *jwksFile); err != nil {
+			log.Fatalf("Failed to generate key: %v", err)
+		}
+		fmt.Printf("Wrote %s and %s\n", *keyFile, *jwksFile)
+		return
+	}
+
+	if *subject == "" || *role == "" {
+		log.Fatal("-sub and -role are required")
+	}
+	key, err := readKey(*keyFile)
+	if err != nil {
+		log.Fatalf("Failed to read key (run with -genkey first): %v", err)
+	}
+
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"sub":  *subject,
+		"role": *role,
+		"iat":  now.Unix(),
+		"exp":  now.Add(*ttl).Unix(),
+	}
+	if *customer != "" {
+		claims["customer_id"] = *customer
+	}
+	if *issuer != "" {
+		claims["iss"] = *issuer
+	}
+	if *audience != "" {
+		claims["aud"] = *audience
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = keyID(&key.PublicKey)
+	signed, err := token.SignedString(key)
+	if err != nil {
+		log.Fatalf("Failed to sign token: %v", err)
+	}
+	fmt.Println(signed)
+}
+
+// generate writes a new RSA key and a JWKS holding its public half
+func generate(keyFile, jwksFile string) error {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return err
+	}
+	block := &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(block), 0600); err != nil {
+		return err
+	}
+
+	jwks := map[string]interface{}{"keys": []map[string]string{{
+		"kty": "RSA",
+		"use": "sig",
+		"alg": "RS256",
+		"kid": keyID(&key.PublicKey),
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}}
+	data, err := json.MarshalIndent(jwks, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(jwksFile, data, 0644)
+}
+
+func readKey(path string) (*rsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s: no PEM data", path)
+	}
+	return x509.ParsePKCS1PrivateKey(block.Bytes)
+}
+
+// keyID names a key after a hash of its modulus
+func keyID(key *rsa.PublicKey) string {
+	sum := sha256.Sum256(key.N.Bytes())
+	return base64.RawURLEncoding.EncodeToString(sum[:8])
+}
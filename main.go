@@ -3,88 +3,203 @@ package main
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
+	"flag"
 	"fmt"
 	"log"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/Scrimzay/supplychain/auth"
+	"github.com/Scrimzay/supplychain/certs"
 	"github.com/Scrimzay/supplychain/db"
+	"github.com/Scrimzay/supplychain/money"
+	"github.com/Scrimzay/supplychain/policy"
 	"github.com/Scrimzay/supplychain/supplychain"
+	"github.com/Scrimzay/supplychain/tax"
 )
 
-// Helper function to format Amount for display
-func formatAmount(amount *supplychain.Amount) *supplychain.Amount {
+// Helper function to format Amount for display in the caller's locale
+func formatAmount(ctx context.Context, amount *supplychain.Amount) *supplychain.Amount {
 	if amount == nil {
 		return amount
 	}
-	// Assume USD with 2 decimal places for simplicity
-	// value is in cents, so divide by 100 for dollars
-	dollars := float64(amount.Value) / 100.0
-	formatted := fmt.Sprintf("%.2f", dollars) // e.g., "1000.00"
-	amount.DisplayValue = formatted
+	amount.DisplayValue = money.Format(amount.Value, amount.Currency, localeFromContext(ctx))
 	return amount
 }
 
+// localeFromContext picks the caller's preferred locale from the accept-language metadata
+func localeFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get("accept-language")) == 0 {
+		return money.DefaultLocale
+	}
+	// "de-CH, de;q=0.9, en;q=0.8" prefers de-CH
+	first, _, _ := strings.Cut(md.Get("accept-language")[0], ",")
+	tag, _, _ := strings.Cut(first, ";")
+	if tag = strings.TrimSpace(tag); tag == "" || tag == "*" {
+		return money.DefaultLocale
+	}
+	return tag
+}
+
 // SupplyChainServer implements the SupplyChain service
 type SupplyChainServer struct {
 	supplychain.UnimplementedSupplyChainServer
-	db *db.DatabaseStruct
+	db  *db.DatabaseStruct
+	tax tax.Calculator
+	// policy holds the roles in force, read from the database unless
+	// policyFile is set
+	policy     *policy.Holder
+	policyFile string
+	// inventory wakes WatchInventory streams after item changes
+	inventory *notifier
+	// orderUpdates wakes WatchOrder and WatchShipment streams after order
+	// or shipment events are recorded
+	orderUpdates *notifier
+}
+
+// principal identifies the caller of an RPC
+type principal struct {
+	KeyID      string // Id of the API key, never the key itself, or jwt:<subject> / cert:<common name>
+	Role       string
+	CustomerID string // Customer a customer key or token acts for
+}
+
+type principalKey struct{}
+
+// principalFromContext returns the caller placed in the context by the interceptor
+func principalFromContext(ctx context.Context) (*principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*principal)
+	return p, ok
+}
+
+// queryer is satisfied by both the database and a transaction
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
 }
 
 func (s *SupplyChainServer) CreateItem(ctx context.Context, req *supplychain.CreateItemRequest) (*supplychain.CreateItemResponse, error) {
-	if req.Name == "" || req.Quantity < 0 || req.UnitPrice.Value < 0 {
+	if req.Name == "" || req.Quantity < 0 || req.UnitPrice == nil || req.UnitPrice.Value < 0 {
 		return nil, status.Error(codes.InvalidArgument,  "Invalid item details")
 	}
+	if !money.Valid(req.UnitPrice.Currency) {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown currency code %q", req.UnitPrice.Currency)
+	}
 
 	item := &supplychain.Item{
 		Id: uuid.New().String(),
 		Name: req.Name,
 		Description: req.Description,
 		Quantity: req.Quantity,
-		UnitPrice: formatAmount(req.UnitPrice),
+		UnitPrice: formatAmount(ctx, req.UnitPrice),
 		UpdatedAt: time.Now().Unix(),
+		TaxCategory: taxCategory(req.TaxCategory),
 	}
 
-	_, err := s.db.ExecContext(ctx,
-		"INSERT INTO items (id, name, description, quantity, unit_price_value, unit_price_currency, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
-		item.Id, item.Name, item.Description, item.Quantity, item.UnitPrice.Value, item.UnitPrice.Currency, item.UpdatedAt)
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO items (id, name, description, quantity, unit_price_value, unit_price_currency, updated_at, tax_category) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		item.Id, item.Name, item.Description, item.Quantity, item.UnitPrice.Value, item.UnitPrice.Currency, item.UpdatedAt, item.TaxCategory)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Failed to create item")
 	}
+	err = recordInventoryEvent(ctx, tx, supplychain.InventoryEventType_INVENTORY_EVENT_ITEM_CREATED,
+		item.Id, item.Name, item.Quantity, item.Quantity, "CreateItem", item.UpdatedAt)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to record inventory event")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to commit transaction")
+	}
+	s.inventory.notify()
 
-	return &supplychain.CreateItemResponse{Item: item}, nil
+	return &supplychain.CreateItemResponse{Item: setAvailability(item, 0)}, nil
 }
 
 func (s *SupplyChainServer) UpdateItem(ctx context.Context, req *supplychain.UpdateItemRequest) (*supplychain.UpdateItemResponse, error) {
-	if req.Id == "" || req.Name == "" || req.Quantity < 0 || req.UnitPrice.Value < 0 {
-		return nil, status.Error(codes.InvalidArgument, "Invalid item details")
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
 	}
+	defer tx.Rollback()
 
-	item := &supplychain.Item{
+	item, _, err := updateItem(ctx, tx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to commit transaction")
+	}
+	s.inventory.notify()
+
+	return &supplychain.UpdateItemResponse{Item: item}, nil
+}
+
+// updateItem writes an item's new details, found is false when there was no
+// item with the id and nothing changed
+func updateItem(ctx context.Context, tx *sql.Tx, req *supplychain.UpdateItemRequest) (item *supplychain.Item, found bool, err error) {
+	if req.Id == "" || req.Name == "" || req.Quantity < 0 || req.UnitPrice == nil || req.UnitPrice.Value < 0 {
+		return nil, false, status.Error(codes.InvalidArgument, "Invalid item details")
+	}
+	if !money.Valid(req.UnitPrice.Currency) {
+		return nil, false, status.Errorf(codes.InvalidArgument, "Unknown currency code %q", req.UnitPrice.Currency)
+	}
+
+	item = &supplychain.Item{
 		Id:          req.Id,
 		Name:        req.Name,
 		Description: req.Description,
 		Quantity:    req.Quantity,
-		UnitPrice:   formatAmount(req.UnitPrice),
+		UnitPrice:   formatAmount(ctx, req.UnitPrice),
 		UpdatedAt:   time.Now().Unix(),
+		TaxCategory: taxCategory(req.TaxCategory),
 	}
 
-	_, err := s.db.ExecContext(ctx,
-		"UPDATE items SET name = ?, description = ?, quantity = ?, unit_price_value = ?, unit_price_currency = ?, updated_at = ? WHERE id = ?",
-		item.Name, item.Description, item.Quantity, item.UnitPrice.Value, item.UnitPrice.Currency, item.UpdatedAt, item.Id)
+	var previous int32
+	err = tx.QueryRowContext(ctx, "SELECT quantity FROM items WHERE id = ?", item.Id).Scan(&previous)
+	found = err == nil
+	if err != nil && err != sql.ErrNoRows {
+		return nil, false, status.Error(codes.Internal, "Failed to check item")
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE items SET name = ?, description = ?, quantity = ?, unit_price_value = ?, unit_price_currency = ?, updated_at = ?, tax_category = ? WHERE id = ?",
+		item.Name, item.Description, item.Quantity, item.UnitPrice.Value, item.UnitPrice.Currency, item.UpdatedAt, item.TaxCategory, item.Id)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "Failed to update item")
+		return nil, false, status.Error(codes.Internal, "Failed to update item")
+	}
+	if found {
+		err = recordInventoryEvent(ctx, tx, supplychain.InventoryEventType_INVENTORY_EVENT_ITEM_UPDATED,
+			item.Id, item.Name, item.Quantity, item.Quantity-previous, "UpdateItem", item.UpdatedAt)
+		if err != nil {
+			return nil, false, status.Error(codes.Internal, "Failed to record inventory event")
+		}
 	}
 
-	return &supplychain.UpdateItemResponse{Item: item}, nil
+	var reserved int32
+	err = tx.QueryRowContext(ctx, "SELECT "+reservedColumn+" FROM items WHERE id = ?", item.UpdatedAt, item.Id).Scan(&reserved)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, false, status.Error(codes.Internal, "Failed to check reservations")
+	}
+
+	return setAvailability(item, reserved), found, nil
 }
 
 func (s *SupplyChainServer) DeleteItem(ctx context.Context, req *supplychain.DeleteItemRequest) (*supplychain.DeleteItemResponse, error) {
@@ -92,23 +207,58 @@ func (s *SupplyChainServer) DeleteItem(ctx context.Context, req *supplychain.Del
 		return nil, status.Error(codes.InvalidArgument, "Item ID required")
 	}
 
-	result, err := s.db.ExecContext(ctx, "DELETE FROM items WHERE id = ?", req.Id)
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "Failed to delete item")
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
 	}
+	defer tx.Rollback()
 
-	rows, _ := result.RowsAffected()
-	if rows == 0 {
+	var name string
+	var quantity int32
+	err = tx.QueryRowContext(ctx, "SELECT name, quantity FROM items WHERE id = ?", req.Id).Scan(&name, &quantity)
+	if err == sql.ErrNoRows {
 		return nil, status.Error(codes.NotFound, "Item not found")
 	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to check item")
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM items WHERE id = ?", req.Id); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to delete item")
+	}
+	err = recordInventoryEvent(ctx, tx, supplychain.InventoryEventType_INVENTORY_EVENT_ITEM_DELETED,
+		req.Id, name, 0, -quantity, "DeleteItem", time.Now().Unix())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to record inventory event")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to commit transaction")
+	}
+	s.inventory.notify()
 
 	return &supplychain.DeleteItemResponse{Success: true}, nil
 }
 
 func (s *SupplyChainServer) CreateOrder(ctx context.Context, req *supplychain.CreateOrderRequest) (*supplychain.CreateOrderResponse, error) {
+	// customers order for themselves unless they say otherwise
+	if scope, ok := customerScope(ctx); ok && req.CustomerId == "" {
+		req.CustomerId = scope
+	}
 	if req.CustomerId == "" || len(req.Items) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "Invalid order details")
 	}
+	if err := checkOwner(ctx, req.CustomerId); err != nil {
+		return nil, err
+	}
+	for _, orderItem := range req.Items {
+		if orderItem.ItemId == "" || orderItem.Quantity <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "Invalid order details")
+		}
+	}
+	if req.SettlementCurrency != "" && !money.Valid(req.SettlementCurrency) {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown currency code %q", req.SettlementCurrency)
+	}
 
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -116,50 +266,130 @@ func (s *SupplyChainServer) CreateOrder(ctx context.Context, req *supplychain.Cr
 	}
 	defer tx.Rollback()
 
+	shipTo, err := shipToAddress(ctx, tx, req.CustomerId, req.ShipToAddressId)
+	if err != nil {
+		return nil, err
+	}
+
+	var createdBy string
+	if p, ok := principalFromContext(ctx); ok {
+		createdBy = p.KeyID
+	}
+
+	now := time.Now()
 	var total int64
+	var shortfalls []*supplychain.StockShortfall
+	var lines []*supplychain.OrderItem
 	for _, orderItem := range req.Items {
-		var unitPrice int64
-		err := tx.QueryRowContext(ctx, "SELECT unit_price_value FROM items WHERE id = ?", orderItem.ItemId).Scan(&unitPrice)
+		// the line keeps a copy of the item as it is now so later price or
+		// catalog changes don't alter what was sold
+		line := &supplychain.OrderItem{ItemId: orderItem.ItemId, Quantity: orderItem.Quantity, UnitPrice: &supplychain.Amount{}}
+		var description sql.NullString
+		var onHand, reserved int32
+		err := tx.QueryRowContext(ctx,
+			"SELECT name, description, unit_price_value, unit_price_currency, tax_category, quantity, "+reservedColumn+" FROM items WHERE id = ?",
+			now.Unix(), orderItem.ItemId).Scan(&line.Name, &description, &line.UnitPrice.Value, &line.UnitPrice.Currency, &line.TaxCategory, &onHand, &reserved)
 		if err == sql.ErrNoRows {
 			return nil, status.Error(codes.NotFound, "Item not found")
 		}
 		if err != nil {
 			return nil, status.Error(codes.Internal, "Failed to check item")
 		}
-		total += unitPrice * int64(orderItem.Quantity)
+		if onHand-reserved < orderItem.Quantity {
+			shortfalls = append(shortfalls, &supplychain.StockShortfall{
+				ItemId:    orderItem.ItemId,
+				Requested: orderItem.Quantity,
+				Available: max(onHand-reserved, 0),
+			})
+		}
+		line.Description = description.String
+		if req.SettlementCurrency != "" {
+			if err := convertLine(ctx, tx, line, req.SettlementCurrency, now.Unix()); err != nil {
+				return nil, err
+			}
+			formatAmount(ctx, line.OriginalUnitPrice)
+			formatAmount(ctx, line.OriginalLineTotal)
+		}
+		line.LineTotal = formatAmount(ctx, &supplychain.Amount{
+			Value:    line.UnitPrice.Value * int64(line.Quantity),
+			Currency: line.UnitPrice.Currency,
+		})
+		formatAmount(ctx, line.UnitPrice)
+		// amounts in different currencies can't simply be added up
+		if len(lines) > 0 && line.UnitPrice.Currency != lines[0].UnitPrice.Currency {
+			return nil, status.Errorf(codes.InvalidArgument, "Order mixes items priced in %s and %s",
+				lines[0].UnitPrice.Currency, line.UnitPrice.Currency)
+		}
+		total += line.LineTotal.Value
+		lines = append(lines, setOutstanding(line))
+	}
+	if len(shortfalls) > 0 {
+		return nil, insufficientStockError("Insufficient stock to reserve order", shortfalls)
 	}
 
 	order := &supplychain.Order{
 		Id:         uuid.New().String(),
 		CustomerId: req.CustomerId,
-		Items:      req.Items,
-		Total: formatAmount(&supplychain.Amount{
+		Items:      lines,
+		Total: formatAmount(ctx, &supplychain.Amount{
 			Value:    total,
-			Currency: "USD", // Assume USD for simplicity
+			Currency: lines[0].UnitPrice.Currency,
 		}),
-		Status:    "PENDING",
-		CreatedAt: time.Now().Unix(),
+		Status:       orderPending,
+		CreatedAt:    now.Unix(),
+		ShipToRegion: taxRegion(shipTo),
+		ShipTo:       shipTo,
+	}
+	if err := discountOrder(ctx, tx, order, req.CouponCodes, now.Unix()); err != nil {
+		return nil, err
+	}
+	if err := s.taxOrder(ctx, order); err != nil {
+		return nil, err
 	}
 
 	_, err = tx.ExecContext(ctx,
-		"INSERT INTO orders (id, customer_id, total_value, total_currency, status, created_at) VALUES (?, ?, ?, ?, ?, ?)",
-		order.Id, order.CustomerId, order.Total.Value, order.Total.Currency, order.Status, order.CreatedAt)
+		`INSERT INTO orders (id, customer_id, total_value, total_currency, status, created_at, created_by,
+		subtotal_value, tax_total_value, ship_to_region, tax_jurisdiction, prices_include_tax, discount_total_value,
+		ship_to_name, ship_to_line1, ship_to_line2, ship_to_city, ship_to_region_code, ship_to_postal_code, ship_to_country)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		append([]interface{}{order.Id, order.CustomerId, order.Total.Value, order.Total.Currency, order.Status, order.CreatedAt, createdBy,
+			order.Subtotal.Value, order.TaxTotal.Value, order.ShipToRegion, order.TaxJurisdiction, order.PricesIncludeTax, order.DiscountTotal.Value},
+			addressValues(shipTo)...)...)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Failed to create order")
 	}
 
 	for _, item := range order.Items {
 		_, err = tx.ExecContext(ctx,
-			"INSERT INTO order_items (order_id, item_id, quantity) VALUES (?, ?, ?)",
-			order.Id, item.ItemId, item.Quantity)
+			`INSERT INTO order_items (order_id, item_id, quantity, unit_price_value, unit_price_currency, item_name, item_description, line_total_value,
+			tax_value, tax_rate, tax_category, discount_value, original_unit_price_value, original_currency, original_line_total_value, exchange_rate, rate_effective_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			append([]interface{}{order.Id, item.ItemId, item.Quantity, item.UnitPrice.Value, item.UnitPrice.Currency,
+				item.Name, item.Description, item.LineTotal.Value, item.Tax.Value, item.TaxRate, item.TaxCategory, item.Discount.Value},
+				conversionColumns(item)...)...)
 		if err != nil {
 			return nil, status.Error(codes.Internal, "Failed to add order items")
 		}
+		if err := reserveStock(ctx, tx, order.Id, item.ItemId, item.Quantity, now); err != nil {
+			return nil, status.Error(codes.Internal, "Failed to reserve stock")
+		}
+	}
+	if err := recordDiscounts(ctx, tx, order); err != nil {
+		return nil, err
 	}
 
+	if err := recordOrderEvent(ctx, tx, order.Id, orderEventStatusChanged, "", orderPending, "Order created"); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to record order event")
+	}
+	if err := transitionOrder(ctx, tx, order.Id, orderPending, orderReserved, "Stock reserved"); err != nil {
+		return nil, err
+	}
+	order.Status = orderReserved
+
 	if err := tx.Commit(); err != nil {
 		return nil, status.Error(codes.Internal, "Failed to commit transaction")
 	}
+	s.orderUpdates.notify()
 
 	return &supplychain.CreateOrderResponse{Order: order}, nil
 }
@@ -169,97 +399,283 @@ func (s *SupplyChainServer) FulfillOrder(ctx context.Context, req *supplychain.F
 		return nil, status.Error(codes.InvalidArgument, "Order ID required")
 	}
 
+	order, backordered, err := s.fulfillOrder(ctx, req.OrderId, req.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &supplychain.FulfillOrderResponse{Order: order, Backordered: backordered}, nil
+}
+
+func (s *SupplyChainServer) CancelOrder(ctx context.Context, req *supplychain.CancelOrderRequest) (*supplychain.CancelOrderResponse, error) {
+	if req.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "Order ID required")
+	}
+
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Failed to start transaction")
 	}
 	defer tx.Rollback()
 
-	var statusReport string
-	err = tx.QueryRowContext(ctx, "SELECT status FROM orders WHERE id = ?", req.OrderId).Scan(&statusReport)
+	if err := checkOrderOwner(ctx, tx, req.OrderId); err != nil {
+		return nil, err
+	}
+
+	var current string
+	err = tx.QueryRowContext(ctx, "SELECT status FROM orders WHERE id = ?", req.OrderId).Scan(&current)
 	if err == sql.ErrNoRows {
 		return nil, status.Error(codes.NotFound, "Order not found")
 	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Failed to check order")
 	}
-	if statusReport != "PENDING" {
-		return nil, status.Error(codes.FailedPrecondition, "Order cannot be fulfilled")
-	}
 
-	rows, err := tx.QueryContext(ctx, "SELECT item_id, quantity FROM order_items WHERE order_id = ?", req.OrderId)
-	if err != nil {
-		return nil, status.Error(codes.Internal, "Failed to fetch order items")
+	if err := transitionOrder(ctx, tx, req.OrderId, current, orderCancelled, req.Reason); err != nil {
+		return nil, err
 	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var itemID string
-		var quantity int32
-		if err := rows.Scan(&itemID, &quantity); err != nil {
-			return nil, status.Error(codes.Internal, "Failed to scan order items")
-		}
-		_, err = tx.ExecContext(ctx, "UPDATE items SET quantity = quantity - ? WHERE id = ? AND quantity >= ?", quantity, itemID, quantity)
-		if err != nil {
-			return nil, status.Error(codes.Internal, "Failed to update inventory")
-		}
+	if err := releaseReservations(ctx, tx, req.OrderId); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to release reservations")
+	}
+	if err := releasePromotions(ctx, tx, req.OrderId); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to release promotions")
 	}
 
-	_, err = tx.ExecContext(ctx, "UPDATE orders SET status = 'FULFILLED' WHERE id = ?", req.OrderId)
+	order, err := loadOrder(ctx, tx, req.OrderId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "Failed to update order")
+		return nil, status.Error(codes.Internal, "Failed to fetch order")
 	}
 
 	if err := tx.Commit(); err != nil {
 		return nil, status.Error(codes.Internal, "Failed to commit transaction")
 	}
+	s.orderUpdates.notify()
 
-	order := &supplychain.Order{Id: req.OrderId, Status: "FULFILLED"}
-	return &supplychain.FulfillOrderResponse{Order: order}, nil
+	return &supplychain.CancelOrderResponse{Order: order}, nil
 }
 
 func (s *SupplyChainServer) GetOrder(ctx context.Context, req *supplychain.GetOrderRequest) (*supplychain.GetOrderResponse, error) {
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "Order ID required")
+	order, history, err := getOrder(ctx, s.db, req.Id)
+	if err != nil {
+		return nil, err
 	}
 
-	var order supplychain.Order
-	var totalValue int64
-	var totalCurrency, statusReport, customerID string
-	var createdAt int64
-	err := s.db.QueryRowContext(ctx,
-		"SELECT id, customer_id, total_value, total_currency, status, created_at FROM orders WHERE id = ?",
-		req.Id).Scan(&order.Id, &customerID, &totalValue, &totalCurrency, &statusReport, &createdAt)
+	return &supplychain.GetOrderResponse{Order: order, History: history}, nil
+}
+
+// getOrder reads an order and its history if the caller may see it
+func getOrder(ctx context.Context, q queryer, id string) (*supplychain.Order, []*supplychain.OrderEvent, error) {
+	if id == "" {
+		return nil, nil, status.Error(codes.InvalidArgument, "Order ID required")
+	}
+
+	order, err := loadOrder(ctx, q, id)
 	if err == sql.ErrNoRows {
-		return nil, status.Error(codes.NotFound, "Order not found")
+		return nil, nil, status.Error(codes.NotFound, "Order not found")
 	}
 	if err != nil {
-		return nil, status.Error(codes.Internal, "Failed to fetch order")
+		return nil, nil, status.Error(codes.Internal, "Failed to fetch order")
+	}
+	if err := checkOwner(ctx, order.CustomerId); err != nil {
+		return nil, nil, err
 	}
 
-	rows, err := s.db.QueryContext(ctx, "SELECT item_id, quantity FROM order_items WHERE order_id = ?", req.Id)
+	history, err := loadOrderHistory(ctx, q, id, 0)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "Failed to fetch order items")
+		return nil, nil, status.Error(codes.Internal, "Failed to fetch order history")
 	}
-	defer rows.Close()
 
-	var items []*supplychain.OrderItem
+	return order, history, nil
+}
+
+func (s *SupplyChainServer) ListOrders(ctx context.Context, req *supplychain.ListOrdersRequest) (*supplychain.ListOrdersResponse, error) {
+	if req.PageSize < 0 || req.PageSize > 100 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid pagination")
+	}
+	if req.Status != "" && !isOrderStatus(req.Status) {
+		return nil, status.Error(codes.InvalidArgument, "Unknown order status")
+	}
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = 20
+	}
+
+	sortColumn := "created_at"
+	if req.SortBy == supplychain.OrderSortField_ORDER_SORT_TOTAL {
+		sortColumn = "total_value"
+	}
+	direction, cmp := "ASC", ">"
+	if req.Descending {
+		direction, cmp = "DESC", "<"
+	}
+
+	query := "SELECT id, " + sortColumn + " FROM orders o WHERE 1 = 1"
+	args := []interface{}{}
+	// customers only ever see their own orders
+	if scope, ok := customerScope(ctx); ok {
+		if req.CustomerId != "" {
+			if err := checkOwner(ctx, req.CustomerId); err != nil {
+				return nil, err
+			}
+		}
+		query += " AND customer_id = ?"
+		args = append(args, scope)
+	}
+	if req.CustomerId != "" {
+		query += " AND customer_id = ?"
+		args = append(args, req.CustomerId)
+	}
+	if req.Status != "" {
+		query += " AND status = ?"
+		args = append(args, req.Status)
+	}
+	if req.CreatedAfter != 0 {
+		query += " AND created_at >= ?"
+		args = append(args, req.CreatedAfter)
+	}
+	if req.CreatedBefore != 0 {
+		query += " AND created_at < ?"
+		args = append(args, req.CreatedBefore)
+	}
+	if req.ItemId != "" {
+		query += " AND EXISTS (SELECT 1 FROM order_items oi WHERE oi.order_id = o.id AND oi.item_id = ?)"
+		args = append(args, req.ItemId)
+	}
+	if req.PageToken != "" {
+		token, err := decodePageToken(req.PageToken)
+		if err != nil || token.SortBy != int32(req.SortBy) || token.Descending != req.Descending {
+			return nil, status.Error(codes.InvalidArgument, "Invalid page token")
+		}
+		query += fmt.Sprintf(" AND (%s %s ? OR (%s = ? AND id %s ?))", sortColumn, cmp, sortColumn, cmp)
+		args = append(args, token.Value, token.Value, token.ID)
+	}
+	// fetch one extra row to learn whether another page follows
+	query += fmt.Sprintf(" ORDER BY %s %s, id %s LIMIT ?", sortColumn, direction, direction)
+	args = append(args, pageSize+1)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to list orders")
+	}
+	var ids []string
+	var sortValues []int64
 	for rows.Next() {
-		var itemID string
-		var quantity int32
-		if err := rows.Scan(&itemID, &quantity); err != nil {
-			return nil, status.Error(codes.Internal, "Failed to scan order items")
+		var id string
+		var sortValue int64
+		if err := rows.Scan(&id, &sortValue); err != nil {
+			rows.Close()
+			return nil, status.Error(codes.Internal, "Failed to scan orders")
+		}
+		ids = append(ids, id)
+		sortValues = append(sortValues, sortValue)
+	}
+	rows.Close()
+
+	resp := &supplychain.ListOrdersResponse{}
+	if len(ids) > int(pageSize) {
+		ids, sortValues = ids[:pageSize], sortValues[:pageSize]
+		last := len(ids) - 1
+		resp.NextPageToken = pageToken{
+			SortBy:     int32(req.SortBy),
+			Descending: req.Descending,
+			Value:      sortValues[last],
+			ID:         ids[last],
+		}.encode()
+	}
+
+	for _, id := range ids {
+		order, err := loadOrder(ctx, s.db, id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Failed to fetch order")
 		}
-		items = append(items, &supplychain.OrderItem{ItemId: itemID, Quantity: quantity})
+		resp.Orders = append(resp.Orders, order)
 	}
 
-	order.CustomerId = customerID
-	order.Items = items
-	order.Total = formatAmount(&supplychain.Amount{Value: totalValue, Currency: totalCurrency})
-	order.Status = statusReport
-	order.CreatedAt = createdAt
+	return resp, nil
+}
+
+// loadOrder reads an order and its lines, it returns sql.ErrNoRows for unknown ids
+func loadOrder(ctx context.Context, q queryer, id string) (*supplychain.Order, error) {
+	var order supplychain.Order
+	var totalValue int64
+	var totalCurrency string
+	var subtotal, taxTotal, discountTotal sql.NullInt64
+	var shipToRegion, taxJurisdiction sql.NullString
+	var pricesIncludeTax sql.NullBool
+	err := q.QueryRowContext(ctx,
+		`SELECT id, customer_id, total_value, total_currency, status, created_at,
+		subtotal_value, tax_total_value, ship_to_region, tax_jurisdiction, prices_include_tax, discount_total_value FROM orders WHERE id = ?`,
+		id).Scan(&order.Id, &order.CustomerId, &totalValue, &totalCurrency, &order.Status, &order.CreatedAt,
+		&subtotal, &taxTotal, &shipToRegion, &taxJurisdiction, &pricesIncludeTax, &discountTotal)
+	if err != nil {
+		return nil, err
+	}
+	order.ShipToRegion = shipToRegion.String
+	order.TaxJurisdiction = taxJurisdiction.String
+	order.PricesIncludeTax = pricesIncludeTax.Bool
+
+	rows, err := q.QueryContext(ctx,
+		`SELECT item_id, quantity, fulfilled_quantity, `+shippedColumn+`,
+		unit_price_value, unit_price_currency, item_name, item_description, line_total_value, tax_value, tax_rate, tax_category, discount_value,
+		original_unit_price_value, original_currency, original_line_total_value, exchange_rate, rate_effective_at
+		FROM order_items WHERE order_id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item supplychain.OrderItem
+		var unitPrice, lineTotal, lineTax, discount, originalUnitPrice, originalLineTotal, rateEffectiveAt sql.NullInt64
+		var currency, name, description, taxRate, taxCategory, originalCurrency, exchangeRate sql.NullString
+		if err := rows.Scan(&item.ItemId, &item.Quantity, &item.FulfilledQuantity, &item.ShippedQuantity,
+			&unitPrice, &currency, &name, &description, &lineTotal, &lineTax, &taxRate, &taxCategory, &discount,
+			&originalUnitPrice, &originalCurrency, &originalLineTotal, &exchangeRate, &rateEffectiveAt); err != nil {
+			return nil, err
+		}
+		if originalUnitPrice.Valid {
+			item.OriginalUnitPrice = formatAmount(ctx, &supplychain.Amount{Value: originalUnitPrice.Int64, Currency: originalCurrency.String})
+			item.OriginalLineTotal = formatAmount(ctx, &supplychain.Amount{Value: originalLineTotal.Int64, Currency: originalCurrency.String})
+			item.ExchangeRate = exchangeRate.String
+			item.RateEffectiveAt = rateEffectiveAt.Int64
+		}
+		// lines of orders placed before prices were snapshotted have none
+		if unitPrice.Valid {
+			item.UnitPrice = formatAmount(ctx, &supplychain.Amount{Value: unitPrice.Int64, Currency: currency.String})
+			item.LineTotal = formatAmount(ctx, &supplychain.Amount{Value: lineTotal.Int64, Currency: currency.String})
+		}
+		// as are taxes of orders placed before tax was calculated
+		if lineTax.Valid {
+			item.Tax = formatAmount(ctx, &supplychain.Amount{Value: lineTax.Int64, Currency: currency.String})
+			item.TaxRate = taxRate.String
+			item.TaxCategory = taxCategory.String
+		}
+		if discount.Valid {
+			item.Discount = formatAmount(ctx, &supplychain.Amount{Value: discount.Int64, Currency: currency.String})
+		}
+		item.Name = name.String
+		item.Description = description.String
+		order.Items = append(order.Items, setOutstanding(&item))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 
-	return &supplychain.GetOrderResponse{Order: &order}, nil
+	order.Total = formatAmount(ctx, &supplychain.Amount{Value: totalValue, Currency: totalCurrency})
+	// untaxed orders are all subtotal
+	if !subtotal.Valid {
+		subtotal.Int64 = totalValue
+	}
+	order.Subtotal = formatAmount(ctx, &supplychain.Amount{Value: subtotal.Int64, Currency: totalCurrency})
+	order.TaxTotal = formatAmount(ctx, &supplychain.Amount{Value: taxTotal.Int64, Currency: totalCurrency})
+	order.DiscountTotal = formatAmount(ctx, &supplychain.Amount{Value: discountTotal.Int64, Currency: totalCurrency})
+	if err := loadOrderDiscounts(ctx, q, &order); err != nil {
+		return nil, err
+	}
+	if order.ShipTo, err = loadShipTo(ctx, q, id); err != nil {
+		return nil, err
+	}
+	return &order, nil
 }
 
 func (s *SupplyChainServer) CreateShipment(ctx context.Context, req *supplychain.CreateShipmentRequest) (*supplychain.CreateShipmentResponse, error) {
@@ -267,56 +683,135 @@ func (s *SupplyChainServer) CreateShipment(ctx context.Context, req *supplychain
 		return nil, status.Error(codes.InvalidArgument, "Invalid shipment details")
 	}
 
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer tx.Rollback()
+
 	var statusReport string
-	err := s.db.QueryRowContext(ctx, "SELECT status FROM orders WHERE id = ?", req.OrderId).Scan(&statusReport)
+	err = tx.QueryRowContext(ctx, "SELECT status FROM orders WHERE id = ?", req.OrderId).Scan(&statusReport)
 	if err == sql.ErrNoRows {
 		return nil, status.Error(codes.NotFound, "Order not found")
 	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Failed to check order")
 	}
-	if statusReport != "FULFILLED" {
-		return nil, status.Error(codes.FailedPrecondition, "Order must be fulfilled")
+	if statusReport != orderFulfilled && statusReport != orderPartiallyFulfilled {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot ship order in status %s", statusReport)
+	}
+
+	contents, complete, err := planParcel(ctx, tx, req.OrderId, req.Items)
+	if err != nil {
+		return nil, err
+	}
+	shipTo, err := loadShipTo(ctx, tx, req.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch ship-to address")
 	}
 
 	shipment := &supplychain.Shipment{
 		Id:            uuid.New().String(),
 		OrderId:       req.OrderId,
-		Status:        "PENDING",
+		Status:        shipmentPending,
 		TrackingNumber: req.TrackingNumber,
 		UpdatedAt:     time.Now().Unix(),
+		Items:         contents,
+		ShipTo:        shipTo,
 	}
 
-	_, err = s.db.ExecContext(ctx,
+	_, err = tx.ExecContext(ctx,
 		"INSERT INTO shipments (id, order_id, status, tracking_number, updated_at) VALUES (?, ?, ?, ?, ?)",
 		shipment.Id, shipment.OrderId, shipment.Status, shipment.TrackingNumber, shipment.UpdatedAt)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Failed to create shipment")
 	}
+	for _, item := range shipment.Items {
+		_, err = tx.ExecContext(ctx,
+			"INSERT INTO shipment_items (shipment_id, item_id, quantity) VALUES (?, ?, ?)",
+			shipment.Id, item.ItemId, item.Quantity)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Failed to add shipment items")
+		}
+	}
+	if err := recordShipmentEvent(ctx, tx, shipment.Id, "", shipment.Status, "", "Shipment created", shipment.UpdatedAt); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to record shipment event")
+	}
+
+	// the parcel that carries the last outstanding units ships the order
+	if complete {
+		if err := transitionOrder(ctx, tx, req.OrderId, statusReport, orderShipped, "All items shipped"); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to commit transaction")
+	}
+	s.orderUpdates.notify()
 
 	return &supplychain.CreateShipmentResponse{Shipment: shipment}, nil
 }
 
 func (s *SupplyChainServer) UpdateShipment(ctx context.Context, req *supplychain.UpdateShipmentRequest) (*supplychain.UpdateShipmentResponse, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	shipment, err := updateShipment(ctx, tx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to commit transaction")
+	}
+	s.orderUpdates.notify()
+
+	return &supplychain.UpdateShipmentResponse{Shipment: shipment}, nil
+}
+
+// updateShipment moves a shipment on and returns it as it is now
+func updateShipment(ctx context.Context, tx *sql.Tx, req *supplychain.UpdateShipmentRequest) (*supplychain.Shipment, error) {
 	if req.Id == "" || req.Status == "" {
 		return nil, status.Error(codes.InvalidArgument, "Invalid shipment details")
 	}
 
-	shipment := &supplychain.Shipment{
-		Id:            req.Id,
-		Status:        req.Status,
-		TrackingNumber: req.TrackingNumber,
-		UpdatedAt:     time.Now().Unix(),
+	if err := updateShipmentStatus(ctx, tx, req); err != nil {
+		return nil, err
 	}
 
-	_, err := s.db.ExecContext(ctx,
-		"UPDATE shipments SET status = ?, tracking_number = ?, updated_at = ? WHERE id = ?",
-		shipment.Status, shipment.TrackingNumber, shipment.UpdatedAt, shipment.Id)
+	shipment, err := loadShipment(ctx, tx, req.Id)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "Failed to update shipment")
+		return nil, status.Error(codes.Internal, "Failed to fetch shipment")
 	}
+	return shipment, nil
+}
 
-	return &supplychain.UpdateShipmentResponse{Shipment: shipment}, nil
+func (s *SupplyChainServer) GetShipment(ctx context.Context, req *supplychain.GetShipmentRequest) (*supplychain.GetShipmentResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "Shipment ID required")
+	}
+
+	shipment, err := loadShipment(ctx, s.db, req.Id)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "Shipment not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch shipment")
+	}
+	if err := checkOrderOwner(ctx, s.db, shipment.OrderId); err != nil {
+		return nil, err
+	}
+
+	events, err := loadShipmentEvents(ctx, s.db, req.Id, 0)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch shipment events")
+	}
+
+	return &supplychain.GetShipmentResponse{Shipment: shipment, Events: events}, nil
 }
 
 func (s *SupplyChainServer) ListItems(ctx context.Context, req *supplychain.ListItemsRequest) (*supplychain.ListItemsResponse, error) {
@@ -324,8 +819,8 @@ func (s *SupplyChainServer) ListItems(ctx context.Context, req *supplychain.List
 		return nil, status.Error(codes.InvalidArgument, "Invalid pagination")
 	}
 
-	query := "SELECT id, name, description, quantity, unit_price_value, unit_price_currency, updated_at FROM items"
-	args := []interface{}{}
+	query := "SELECT id, name, description, quantity, unit_price_value, unit_price_currency, updated_at, tax_category, " + reservedColumn + " FROM items"
+	args := []interface{}{time.Now().Unix()}
 	if req.NameFilter != "" {
 		query += " WHERE name LIKE ?"
 		args = append(args, "%"+req.NameFilter+"%")
@@ -344,11 +839,12 @@ func (s *SupplyChainServer) ListItems(ctx context.Context, req *supplychain.List
 		var item supplychain.Item
 		var unitPriceValue int64
 		var unitPriceCurrency string
-		if err := rows.Scan(&item.Id, &item.Name, &item.Description, &item.Quantity, &unitPriceValue, &unitPriceCurrency, &item.UpdatedAt); err != nil {
+		var reserved int32
+		if err := rows.Scan(&item.Id, &item.Name, &item.Description, &item.Quantity, &unitPriceValue, &unitPriceCurrency, &item.UpdatedAt, &item.TaxCategory, &reserved); err != nil {
 			return nil, status.Error(codes.Internal, "Failed to scan items")
 		}
-		item.UnitPrice = formatAmount(&supplychain.Amount{Value: unitPriceValue, Currency: unitPriceCurrency})
-		items = append(items, &item)
+		item.UnitPrice = formatAmount(ctx, &supplychain.Amount{Value: unitPriceValue, Currency: unitPriceCurrency})
+		items = append(items, setAvailability(&item, reserved))
 	}
 
 	var total int32
@@ -365,13 +861,23 @@ func (s *SupplyChainServer) ListShipments(ctx context.Context, req *supplychain.
 		return nil, status.Error(codes.InvalidArgument, "Invalid pagination")
 	}
 
-	query := "SELECT id, order_id, status, tracking_number, updated_at FROM shipments"
+	where := " WHERE 1 = 1"
 	args := []interface{}{}
 	if req.OrderId != "" {
-		query += " WHERE order_id = ?"
+		if err := checkOrderOwner(ctx, s.db, req.OrderId); err != nil {
+			return nil, err
+		}
+		where += " AND order_id = ?"
 		args = append(args, req.OrderId)
 	}
-	query += " LIMIT ? OFFSET ?"
+	// customers only see the shipments of their own orders
+	if scope, ok := customerScope(ctx); ok {
+		where += " AND order_id IN (SELECT id FROM orders WHERE customer_id = ?)"
+		args = append(args, scope)
+	}
+	countArgs := args
+
+	query := "SELECT id, order_id, status, tracking_number, updated_at FROM shipments" + where + " LIMIT ? OFFSET ?"
 	args = append(args, req.PageSize, (req.Page-1)*req.PageSize)
 
 	rows, err := s.db.QueryContext(ctx, query, args...)
@@ -388,15 +894,21 @@ func (s *SupplyChainServer) ListShipments(ctx context.Context, req *supplychain.
 		}
 		shipments = append(shipments, &shipment)
 	}
+	rows.Close()
 
-	var total int32
-	countQuery := "SELECT COUNT(*) FROM shipments"
-	countArgs := []interface{}{}
-	if req.OrderId != "" {
-		countQuery += " WHERE order_id = ?"
-		countArgs = append(countArgs, req.OrderId)
+	for _, shipment := range shipments {
+		shipment.Items, err = loadShipmentItems(ctx, s.db, shipment.Id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Failed to fetch shipment items")
+		}
+		shipment.ShipTo, err = loadShipTo(ctx, s.db, shipment.OrderId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Failed to fetch ship-to address")
+		}
 	}
-	err = s.db.QueryRowContext(ctx, countQuery, countArgs...).Scan(&total)
+
+	var total int32
+	err = s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM shipments"+where, countArgs...).Scan(&total)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Failed to count shipments")
 	}
@@ -432,108 +944,152 @@ func (s *SupplyChainServer) AuditLogs(ctx context.Context, req *supplychain.Audi
 	return &supplychain.AuditLogsResponse{Logs: protoLogs, Total: total}, nil
 }
 
-//UnaryInterceptor for auth
-func unaryInterceptor(db *db.DatabaseStruct) grpc.UnaryServerInterceptor {
-	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		// extract api key from metadata
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "No metadata provided")
+func main() {
+	taxRules := flag.String("taxrules", "", "JSON file of tax rules, orders are untaxed without one")
+	dev := flag.Bool("dev", false, "Development mode, adds the well known keys from the README")
+	policyFile := flag.String("policy", "", "JSON file of roles to use instead of the roles in the database, reloaded when it changes")
+	jwks := flag.String("jwks", "", "JWKS file or URL, bearer tokens signed by its keys are accepted alongside API keys")
+	jwtIssuer := flag.String("jwtissuer", "", "Issuer bearer tokens must have")
+	jwtAudience := flag.String("jwtaudience", "", "Audience bearer tokens must have")
+	jwtRoleClaim := flag.String("jwtroleclaim", "role", "Token claim holding the role, a string or list")
+	jwtRoleMap := flag.String("jwtrolemap", "", "Renames token roles to local roles, e.g. warehouse=clerk,ops=admin")
+	jwtCustomerClaim := flag.String("jwtcustomerclaim", "customer_id", "Token claim holding the customer ID")
+	tlsCert := flag.String("tlscert", "", "TLS certificate file, the server is plaintext without one")
+	tlsKey := flag.String("tlskey", "", "TLS private key file")
+	clientCA := flag.String("clientca", "", "CA file client certificates are checked against")
+	requireClientCert := flag.Bool("requireclientcert", false, "Refuse connections without a client certificate signed by -clientca")
+	clientCerts := flag.String("clientcerts", "", "JSON file mapping client certificate subjects to roles, those clients need no API key")
+	rateLimitFile := flag.String("ratelimits", "", "JSON file of rate limits and daily quotas per role and caller, calls are unlimited without one")
+	flag.Parse()
+
+	db, err := db.InitDB("supplychain.db")
+	if err != nil {
+		log.Fatalf("Failed to init database: %v", err)
+	}
+	defer db.Close()
+
+	if *dev {
+		if err := db.SeedDevData(); err != nil {
+			log.Fatalf("Failed to seed development data: %v", err)
 		}
-		apiKeys := md.Get("api-key")
-		if len(apiKeys) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "API key required")
+		log.Println("Development mode, the README keys work")
+	}
+	// a new database gets a first admin key so there is a way in
+	hasAdmin, err := db.HasAdminKey()
+	if err != nil {
+		log.Fatalf("Failed to check API keys: %v", err)
+	}
+	if !hasAdmin {
+		_, key, err := db.CreateAPIKey("admin", "", "Bootstrap admin key", 0)
+		if err != nil {
+			log.Fatalf("Failed to create admin key: %v", err)
 		}
-		apiKey := apiKeys[0]
+		log.Printf("No admin API key found, created one: %s (it won't be shown again)", key)
+	}
+
+	lis, err := net.Listen("tcp", ":8089")
+	if err != nil {
+		log.Fatalf("Could not listen: %v", err)
+	}
 
-		// validate api key
-		role, err := db.ValidateAPIKey(apiKey)
+	if err := db.SeedRoles(defaultRoles); err != nil {
+		log.Fatalf("Failed to seed roles: %v", err)
+	}
+	var roles *policy.Policy
+	if *policyFile != "" {
+		roles, err = policy.LoadFile(*policyFile)
+	} else {
+		roles, err = loadPolicy(db)
+	}
+	if err != nil {
+		log.Fatalf("Failed to load policy: %v", err)
+	}
+	policies := policy.NewHolder(roles)
+	if *policyFile != "" {
+		go watchPolicyFile(*policyFile, policies, 5*time.Second)
+	}
+
+	// api keys always work, bearer tokens only with a JWKS to check them
+	authenticators := auth.Chain{auth.APIKeys{Store: db}}
+	if *jwks != "" {
+		roleMap, err := auth.ParseRoleMap(*jwtRoleMap)
 		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, err.Error())
-		}
-
-		// Define allowed methods per role
-		allowedMethods := map[string][]string{
-			"customer": {
-				"/supplychain.SupplyChain/CreateOrder",
-				"/supplychain.SupplyChain/ListItems",
-				"/supplychain.SupplyChain/GetOrder",
-			},
-			"admin": {
-				"/supplychain.SupplyChain/CreateItem",
-				"/supplychain.SupplyChain/UpdateItem",
-				"/supplychain.SupplyChain/DeleteItem",
-				"/supplychain.SupplyChain/CreateOrder",
-				"/supplychain.SupplyChain/FulfillOrder",
-				"/supplychain.SupplyChain/GetOrder",
-				"/supplychain.SupplyChain/CreateShipment",
-				"/supplychain.SupplyChain/UpdateShipment",
-				"/supplychain.SupplyChain/ListItems",
-				"/supplychain.SupplyChain/ListShipments",
-				"/supplychain.SupplyChain/AuditLogs",
-			},
-		}
-
-		allowed := false
-		// check if the method is allowed for the role
-		for _, method := range allowedMethods[role] {
-			if method == info.FullMethod {
-				allowed = true
-				break
-			}
+			log.Fatalf("Failed to read role map: %v", err)
 		}
-		if !allowed {
-			return nil, status.Error(codes.PermissionDenied, "Method not allowed for role")
+		keys, err := auth.LoadKeySet(*jwks)
+		if err != nil {
+			log.Fatalf("Failed to load JWKS: %v", err)
 		}
-
-		// serialize request to json
-		requestData, err := json.Marshal(req)
+		go keys.Watch(10 * time.Minute)
+		authenticators = append(auth.Chain{auth.NewJWT(keys, auth.JWTConfig{
+			Issuer:        *jwtIssuer,
+			Audience:      *jwtAudience,
+			RoleClaim:     *jwtRoleClaim,
+			RoleMap:       roleMap,
+			CustomerClaim: *jwtCustomerClaim,
+			Leeway:        30 * time.Second,
+		}, policies)}, authenticators...)
+	}
+	if *clientCerts != "" {
+		if *clientCA == "" {
+			log.Fatal("-clientcerts needs -clientca to check the certificates")
+		}
+		subjects, err := auth.LoadClientCerts(*clientCerts)
 		if err != nil {
-			log.Printf("Failed to serialize request: %v", err)
-			requestData = []byte("{}")
+			log.Fatalf("Failed to load client certificate subjects: %v", err)
 		}
+		authenticators = append(authenticators, subjects)
+	}
 
-		// call the handler
-		resp, err := handler(ctx, req)
-
-		// log the request
-		logStatus := "success"
+	var opts []grpc.ServerOption
+	if *tlsCert != "" || *tlsKey != "" || *clientCA != "" {
+		reloader, err := certs.NewReloader(certs.ServerOptions{
+			CertFile:          *tlsCert,
+			KeyFile:           *tlsKey,
+			ClientCAFile:      *clientCA,
+			RequireClientCert: *requireClientCert,
+		})
 		if err != nil {
-			logStatus = status.Code(err).String()
-		}
-		_, dbErr := db.ExecContext(ctx,
-		"INSERT INTO audit_logs (api_key, method, request_data, status, timestamp) VALUES (?, ?, ?, ?, ?)",
-		apiKey, info.FullMethod, string(requestData), logStatus, time.Now().Unix())
-		if dbErr != nil {
-			log.Printf("Failed to save audit log: %v", err)
+			log.Fatalf("Failed to load TLS certificates: %v", err)
 		}
-
-		return resp, err
+		go reloader.Watch(5 * time.Second)
+		opts = append(opts, grpc.Creds(credentials.NewTLS(reloader.Config())))
+	} else if *requireClientCert {
+		log.Fatal("-requireclientcert needs -tlscert, -tlskey and -clientca")
 	}
-}
 
-func main() {
-	db, err := db.InitDB("supplychain.db")
-	if err != nil {
-		log.Fatalf("Failed to init database: %v", err)
+	// create a grpc server with interceptor
+	var limits *rateLimits
+	if *rateLimitFile != "" {
+		if limits, err = newRateLimits(db, *rateLimitFile); err != nil {
+			log.Fatalf("Failed to load rate limits: %v", err)
+		}
+		go pruneQuotaUsage(db, time.Hour)
 	}
-	defer db.Close()
 
-	lis, err := net.Listen("tcp", ":8089")
-	if err != nil {
-		log.Fatalf("Could not listen: %v", err)
+	// unary and streaming calls pass the same checks
+	calls := &gate{db: db, authenticator: authenticators, policies: policies, limits: limits}
+	opts = append(opts, grpc.UnaryInterceptor(calls.unary), grpc.StreamInterceptor(calls.stream))
+	server := grpc.NewServer(opts...)
+	service := &SupplyChainServer{db: db, tax: tax.None{}, policy: policies, policyFile: *policyFile,
+		inventory: newNotifier(), orderUpdates: newNotifier()}
+	if *taxRules != "" {
+		rules, err := tax.LoadRules(*taxRules)
+		if err != nil {
+			log.Fatalf("Failed to load tax rules: %v", err)
+		}
+		service.tax = rules
 	}
 
-	// create a grpc server with interceptor
-	server := grpc.NewServer(
-		grpc.UnaryInterceptor(unaryInterceptor(db)),
-	)
-	service := &SupplyChainServer{db: db}
+	// lapse reservations held by orders that were never fulfilled
+	go expireReservations(db, time.Minute)
+	// inventory events can be replayed for a week
+	go pruneInventoryEvents(db, time.Hour)
 
 	// register service
 	supplychain.RegisterSupplyChainServer(server, service)
 
-	log.Println("Starting gRPC server on :8089")
+	log.Printf("Starting gRPC server on :8089 (TLS: %v)", *tlsCert != "")
 	if err := server.Serve(lis); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
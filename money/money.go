@@ -0,0 +1,152 @@
+// Package money handles ISO 4217 currency codes and amounts kept in a
+// currency's minor unit (cents for USD, yen for JPY, fils for KWD)
+package money
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// DefaultLocale is used when a caller doesn't ask for one
+const DefaultLocale = "en-US"
+
+var ErrUnknownCurrency = errors.New("unknown currency code")
+
+// exponents holds the number of minor-unit digits of every active ISO 4217 currency
+var exponents = map[string]int{
+	// no minor unit
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0, "PYG": 0,
+	"RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	// thousandths
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	// ten-thousandths
+	"CLF": 4, "UYW": 4,
+	// hundredths
+	"AED": 2, "AFN": 2, "ALL": 2, "AMD": 2, "ANG": 2, "AOA": 2, "ARS": 2, "AUD": 2, "AWG": 2,
+	"AZN": 2, "BAM": 2, "BBD": 2, "BDT": 2, "BGN": 2, "BMD": 2, "BND": 2, "BOB": 2, "BOV": 2,
+	"BRL": 2, "BSD": 2, "BTN": 2, "BWP": 2, "BYN": 2, "BZD": 2, "CAD": 2, "CDF": 2, "CHE": 2,
+	"CHF": 2, "CHW": 2, "CNY": 2, "COP": 2, "COU": 2, "CRC": 2, "CUP": 2, "CVE": 2, "CZK": 2,
+	"DKK": 2, "DOP": 2, "DZD": 2, "EGP": 2, "ERN": 2, "ETB": 2, "EUR": 2, "FJD": 2, "FKP": 2,
+	"GBP": 2, "GEL": 2, "GHS": 2, "GIP": 2, "GMD": 2, "GTQ": 2, "GYD": 2, "HKD": 2, "HNL": 2,
+	"HTG": 2, "HUF": 2, "IDR": 2, "ILS": 2, "INR": 2, "IRR": 2, "JMD": 2, "KES": 2, "KGS": 2,
+	"KHR": 2, "KPW": 2, "KYD": 2, "KZT": 2, "LAK": 2, "LBP": 2, "LKR": 2, "LRD": 2, "LSL": 2,
+	"MAD": 2, "MDL": 2, "MGA": 2, "MKD": 2, "MMK": 2, "MNT": 2, "MOP": 2, "MRU": 2, "MUR": 2,
+	"MVR": 2, "MWK": 2, "MXN": 2, "MXV": 2, "MYR": 2, "MZN": 2, "NAD": 2, "NGN": 2, "NIO": 2,
+	"NOK": 2, "NPR": 2, "NZD": 2, "PAB": 2, "PEN": 2, "PGK": 2, "PHP": 2, "PKR": 2, "PLN": 2,
+	"QAR": 2, "RON": 2, "RSD": 2, "RUB": 2, "SAR": 2, "SBD": 2, "SCR": 2, "SDG": 2, "SEK": 2,
+	"SGD": 2, "SHP": 2, "SLE": 2, "SOS": 2, "SRD": 2, "SSP": 2, "STN": 2, "SVC": 2, "SYP": 2,
+	"SZL": 2, "THB": 2, "TJS": 2, "TMT": 2, "TOP": 2, "TRY": 2, "TTD": 2, "TWD": 2, "TZS": 2,
+	"UAH": 2, "USD": 2, "USN": 2, "UYU": 2, "UZS": 2, "VED": 2, "VES": 2, "WST": 2, "XCD": 2,
+	"XCG": 2, "YER": 2, "ZAR": 2, "ZMW": 2, "ZWG": 2,
+}
+
+// Valid reports whether code is an active ISO 4217 currency code
+func Valid(code string) bool {
+	_, ok := exponents[code]
+	return ok
+}
+
+// Exponent returns the number of minor-unit digits of a currency
+func Exponent(code string) (int, error) {
+	exp, ok := exponents[code]
+	if !ok {
+		return 0, ErrUnknownCurrency
+	}
+	return exp, nil
+}
+
+// ToMinor converts an amount in major units (e.g. 12.34 dollars) into minor units
+func ToMinor(major float64, code string) (int64, error) {
+	exp, err := Exponent(code)
+	if err != nil {
+		return 0, err
+	}
+	return int64(math.Round(major * math.Pow10(exp))), nil
+}
+
+// separators are the digit grouping and decimal marks of a locale
+type separators struct {
+	group   string
+	decimal string
+}
+
+// locales maps language tags to their number formatting, a full tag
+// (de-CH) wins over its language (de)
+var locales = map[string]separators{
+	"en":    {",", "."},
+	"ja":    {",", "."},
+	"ko":    {",", "."},
+	"zh":    {",", "."},
+	"th":    {",", "."},
+	"he":    {",", "."},
+	"de":    {".", ","},
+	"de-CH": {"’", "."},
+	"es":    {".", ","},
+	"es-MX": {",", "."},
+	"it":    {".", ","},
+	"nl":    {".", ","},
+	"pt":    {".", ","},
+	"id":    {".", ","},
+	"tr":    {".", ","},
+	"da":    {".", ","},
+	"fr":    {"\u202f", ","},
+	"fr-CH": {"\u202f", "."},
+	"sv":    {"\u00a0", ","},
+	"nb":    {"\u00a0", ","},
+	"fi":    {"\u00a0", ","},
+	"pl":    {"\u00a0", ","},
+	"cs":    {"\u00a0", ","},
+	"ru":    {"\u00a0", ","},
+	"uk":    {"\u00a0", ","},
+}
+
+// lookupLocale finds the formatting for a language tag, falling back to the default
+func lookupLocale(locale string) separators {
+	locale = strings.ReplaceAll(strings.TrimSpace(locale), "_", "-")
+	if sep, ok := locales[locale]; ok {
+		return sep
+	}
+	lang, _, _ := strings.Cut(locale, "-")
+	if sep, ok := locales[strings.ToLower(lang)]; ok {
+		return sep
+	}
+	return locales["en"]
+}
+
+// Format renders an amount in minor units as a number in the given locale,
+// e.g. 123456 USD is "1,234.56" in en-US and "1.234,56" in de-DE. Unknown
+// currencies are shown with two decimals.
+func Format(value int64, code, locale string) string {
+	exp, err := Exponent(code)
+	if err != nil {
+		exp = 2
+	}
+	sep := lookupLocale(locale)
+
+	sign := ""
+	if value < 0 {
+		sign = "-"
+		value = -value
+	}
+	digits := strconv.FormatInt(value, 10)
+	if len(digits) <= exp {
+		digits = strings.Repeat("0", exp-len(digits)+1) + digits
+	}
+	whole, fraction := digits[:len(digits)-exp], digits[len(digits)-exp:]
+
+	var b strings.Builder
+	b.WriteString(sign)
+	for i, d := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteString(sep.group)
+		}
+		b.WriteRune(d)
+	}
+	if exp > 0 {
+		b.WriteString(sep.decimal)
+		b.WriteString(fraction)
+	}
+	return b.String()
+}
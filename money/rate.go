@@ -0,0 +1,80 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+)
+
+var ErrInvalidRate = errors.New("invalid exchange rate")
+
+// ParseRate reads an exchange rate written as a decimal number ("0.9215"),
+// it has to be positive
+func ParseRate(s string) (*big.Rat, error) {
+	rate, ok := new(big.Rat).SetString(s)
+	if !ok || rate.Sign() <= 0 {
+		return nil, ErrInvalidRate
+	}
+	return rate, nil
+}
+
+// FormatRate writes a rate back out as a decimal number with up to 10 places
+func FormatRate(rate *big.Rat) string {
+	s := rate.FloatString(10)
+	for s[len(s)-1] == '0' {
+		s = s[:len(s)-1]
+	}
+	if s[len(s)-1] == '.' {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+// Convert turns an amount in minor units of one currency into minor units of
+// another. The rate is the price of one major unit of from in major units of
+// to, so 1000 USD cents at 0.92 is 920 EUR cents and 155 JPY at 0.0064 is
+// 99 USD cents. Halves round away from zero.
+func Convert(value int64, from, to string, rate *big.Rat) (int64, error) {
+	fromExp, err := Exponent(from)
+	if err != nil {
+		return 0, err
+	}
+	toExp, err := Exponent(to)
+	if err != nil {
+		return 0, err
+	}
+
+	converted := new(big.Rat).Mul(new(big.Rat).SetInt64(value), rate)
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(toExp-fromExp))), nil))
+	if toExp > fromExp {
+		converted.Mul(converted, scale)
+	} else {
+		converted.Quo(converted, scale)
+	}
+
+	return Round(converted)
+}
+
+var ErrOverflow = errors.New("amount out of range")
+
+// Round rounds a fractional amount of minor units to a whole one, halves
+// round away from zero
+func Round(x *big.Rat) (int64, error) {
+	// (2|n| + d) / 2d
+	num, den := new(big.Int).Abs(x.Num()), x.Denom()
+	twice := new(big.Int).Add(new(big.Int).Lsh(num, 1), den)
+	rounded := twice.Quo(twice, new(big.Int).Lsh(den, 1))
+	if x.Sign() < 0 {
+		rounded.Neg(rounded)
+	}
+	if !rounded.IsInt64() {
+		return 0, ErrOverflow
+	}
+	return rounded.Int64(), nil
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Scrimzay/supplychain/supplychain"
+)
+
+// Order statuses
+const (
+	orderPending            = "PENDING"
+	orderReserved           = "RESERVED"
+	orderPartiallyFulfilled = "PARTIALLY_FULFILLED"
+	orderBackordered        = "BACKORDERED"
+	orderFulfilled          = "FULFILLED"
+	orderShipped            = "SHIPPED"
+	orderDelivered          = "DELIVERED"
+	orderCancelled          = "CANCELLED"
+)
+
+// Order event types
+const (
+	orderEventStatusChanged = "STATUS_CHANGED"
+)
+
+// orderTransitions lists the statuses an order may move to from each status
+var orderTransitions = map[string][]string{
+	orderPending:            {orderReserved, orderPartiallyFulfilled, orderBackordered, orderFulfilled, orderCancelled},
+	orderReserved:           {orderPartiallyFulfilled, orderBackordered, orderFulfilled, orderCancelled},
+	orderBackordered:        {orderPartiallyFulfilled, orderFulfilled, orderCancelled},
+	orderPartiallyFulfilled: {orderPartiallyFulfilled, orderFulfilled},
+	orderFulfilled:          {orderShipped},
+	orderShipped:            {orderDelivered},
+}
+
+// isOrderStatus reports whether s is a known order status
+func isOrderStatus(s string) bool {
+	switch s {
+	case orderPending, orderReserved, orderPartiallyFulfilled, orderBackordered,
+		orderFulfilled, orderShipped, orderDelivered, orderCancelled:
+		return true
+	}
+	return false
+}
+
+// canTransitionOrder reports whether an order in status from may move to status to
+func canTransitionOrder(from, to string) bool {
+	for _, next := range orderTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// orderTransitionError is returned when an order can't move to the attempted status
+func orderTransitionError(from, to string) error {
+	return status.Errorf(codes.FailedPrecondition, "Cannot move order from %s to %s", from, to)
+}
+
+// recordOrderEvent appends an entry to the order's history
+func recordOrderEvent(ctx context.Context, tx *sql.Tx, orderID, eventType, from, to, note string) error {
+	_, err := tx.ExecContext(ctx,
+		"INSERT INTO order_events (order_id, type, from_status, to_status, note, timestamp) VALUES (?, ?, ?, ?, ?, ?)",
+		orderID, eventType, from, to, note, time.Now().Unix())
+	return err
+}
+
+// transitionOrder moves an order between statuses and records the change,
+// the update only applies while the order is still in status from
+func transitionOrder(ctx context.Context, tx *sql.Tx, orderID, from, to, note string) error {
+	if !canTransitionOrder(from, to) {
+		return orderTransitionError(from, to)
+	}
+
+	result, err := tx.ExecContext(ctx, "UPDATE orders SET status = ? WHERE id = ? AND status = ?", to, orderID, from)
+	if err != nil {
+		return status.Error(codes.Internal, "Failed to update order")
+	}
+	if n, _ := result.RowsAffected(); n != 1 {
+		return status.Error(codes.Aborted, "Order changed concurrently")
+	}
+
+	if err := recordOrderEvent(ctx, tx, orderID, orderEventStatusChanged, from, to, note); err != nil {
+		return status.Error(codes.Internal, "Failed to record order event")
+	}
+	return nil
+}
+
+// loadOrderHistory returns the events recorded for an order after an event
+// id (0 for all of them), oldest first
+func loadOrderHistory(ctx context.Context, q queryer, orderID string, afterID int64) ([]*supplychain.OrderEvent, error) {
+	rows, err := q.QueryContext(ctx,
+		"SELECT id, order_id, type, from_status, to_status, COALESCE(note, ''), timestamp FROM order_events WHERE order_id = ? AND id > ? ORDER BY id",
+		orderID, afterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*supplychain.OrderEvent
+	for rows.Next() {
+		var event supplychain.OrderEvent
+		if err := rows.Scan(&event.Id, &event.OrderId, &event.Type, &event.FromStatus, &event.ToStatus, &event.Note, &event.Timestamp); err != nil {
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+	return events, rows.Err()
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errCrossTenant is returned when a customer reaches for another customer's
+// records, the interceptor audits the call with its PermissionDenied status
+var errCrossTenant = status.Error(codes.PermissionDenied, "Belongs to another customer")
+
+// customerScope returns the customer a caller is limited to. Keys bound to
+// a customer are, as are customer keys without one. Others get ok == false.
+func customerScope(ctx context.Context) (customerID string, ok bool) {
+	p, found := principalFromContext(ctx)
+	if !found || (p.Role != "customer" && p.CustomerID == "") {
+		return "", false
+	}
+	return p.CustomerID, true
+}
+
+// checkOwner denies customers access to records of any other customer
+func checkOwner(ctx context.Context, customerID string) error {
+	scope, ok := customerScope(ctx)
+	if !ok {
+		return nil
+	}
+	// a customer key bound to no customer owns nothing
+	if scope == "" || scope != customerID {
+		p, _ := principalFromContext(ctx)
+		log.Printf("Denied key %s (customer %q) access to records of customer %q", p.KeyID, scope, customerID)
+		return errCrossTenant
+	}
+	return nil
+}
+
+// checkOrderOwner checks that the caller may see an order
+func checkOrderOwner(ctx context.Context, q queryer, orderID string) error {
+	if _, ok := customerScope(ctx); !ok {
+		return nil
+	}
+	var customerID string
+	err := q.QueryRowContext(ctx, "SELECT customer_id FROM orders WHERE id = ?", orderID).Scan(&customerID)
+	if err == sql.ErrNoRows {
+		return status.Error(codes.NotFound, "Order not found")
+	}
+	if err != nil {
+		return status.Error(codes.Internal, "Failed to check order")
+	}
+	return checkOwner(ctx, customerID)
+}
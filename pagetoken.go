@@ -0,0 +1,38 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// pageToken is the cursor behind ListOrders page tokens, it remembers the
+// sort key and id of the last order on a page along with the ordering it
+// was issued for
+type pageToken struct {
+	SortBy     int32  `json:"s"`
+	Descending bool   `json:"d"`
+	Value      int64  `json:"v"`
+	ID         string `json:"id"`
+}
+
+var errInvalidPageToken = errors.New("invalid page token")
+
+// encode turns the cursor into an opaque string for clients
+func (t pageToken) encode() string {
+	data, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken parses a token handed out by encode
+func decodePageToken(token string) (pageToken, error) {
+	var t pageToken
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return t, errInvalidPageToken
+	}
+	if err := json.Unmarshal(data, &t); err != nil || t.ID == "" {
+		return t, errInvalidPageToken
+	}
+	return t, nil
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Scrimzay/supplychain/supplychain"
+)
+
+// shippedColumn sums what the order's shipments carry of an order_items line
+const shippedColumn = `COALESCE((SELECT SUM(si.quantity) FROM shipment_items si JOIN shipments s ON s.id = si.shipment_id
+	WHERE s.order_id = order_items.order_id AND si.item_id = order_items.item_id), 0)`
+
+// setOutstanding fills in how much of an order line is still to be shipped
+func setOutstanding(line *supplychain.OrderItem) *supplychain.OrderItem {
+	line.OutstandingQuantity = max(line.Quantity-line.ShippedQuantity, 0)
+	return line
+}
+
+// planParcel works out the contents of a new shipment for an order. An empty
+// request takes everything fulfilled but not yet shipped. It also reports
+// whether the order is completely shipped once the parcel goes out.
+func planParcel(ctx context.Context, tx *sql.Tx, orderID string, requested []*supplychain.OrderItem) ([]*supplychain.OrderItem, bool, error) {
+	rows, err := tx.QueryContext(ctx,
+		"SELECT item_id, quantity, fulfilled_quantity, "+shippedColumn+" FROM order_items WHERE order_id = ?", orderID)
+	if err != nil {
+		return nil, false, status.Error(codes.Internal, "Failed to fetch order items")
+	}
+	lines := map[string]*supplychain.OrderItem{}
+	var order []string
+	for rows.Next() {
+		var line supplychain.OrderItem
+		if err := rows.Scan(&line.ItemId, &line.Quantity, &line.FulfilledQuantity, &line.ShippedQuantity); err != nil {
+			rows.Close()
+			return nil, false, status.Error(codes.Internal, "Failed to scan order items")
+		}
+		lines[line.ItemId] = &line
+		order = append(order, line.ItemId)
+	}
+	rows.Close()
+
+	parcel := map[string]int32{}
+	var contents []*supplychain.OrderItem
+	if len(requested) == 0 {
+		for _, itemID := range order {
+			line := lines[itemID]
+			if ready := line.FulfilledQuantity - line.ShippedQuantity; ready > 0 {
+				parcel[itemID] = ready
+				contents = append(contents, &supplychain.OrderItem{ItemId: itemID, Quantity: ready})
+			}
+		}
+		if len(contents) == 0 {
+			return nil, false, status.Error(codes.FailedPrecondition, "Nothing left to ship on order")
+		}
+	}
+	for _, item := range requested {
+		line, ok := lines[item.ItemId]
+		if !ok {
+			return nil, false, status.Errorf(codes.InvalidArgument, "Item %s is not on the order", item.ItemId)
+		}
+		if item.Quantity <= 0 {
+			return nil, false, status.Error(codes.InvalidArgument, "Invalid shipment quantity")
+		}
+		if _, dup := parcel[item.ItemId]; dup {
+			return nil, false, status.Errorf(codes.InvalidArgument, "Item %s listed twice", item.ItemId)
+		}
+		if line.ShippedQuantity+item.Quantity > line.Quantity {
+			return nil, false, status.Errorf(codes.FailedPrecondition,
+				"Shipment exceeds ordered quantity for item %s: ordered %d, already shipped %d, requested %d",
+				item.ItemId, line.Quantity, line.ShippedQuantity, item.Quantity)
+		}
+		if line.ShippedQuantity+item.Quantity > line.FulfilledQuantity {
+			return nil, false, status.Errorf(codes.FailedPrecondition,
+				"Item %s has only %d fulfilled units left to ship", item.ItemId, line.FulfilledQuantity-line.ShippedQuantity)
+		}
+		parcel[item.ItemId] = item.Quantity
+		contents = append(contents, &supplychain.OrderItem{ItemId: item.ItemId, Quantity: item.Quantity})
+	}
+
+	complete := true
+	for _, line := range lines {
+		if line.ShippedQuantity+parcel[line.ItemId] < line.Quantity {
+			complete = false
+		}
+	}
+	return contents, complete, nil
+}
+
+// loadShipmentItems returns the order lines a shipment carries
+func loadShipmentItems(ctx context.Context, q queryer, shipmentID string) ([]*supplychain.OrderItem, error) {
+	rows, err := q.QueryContext(ctx, "SELECT item_id, quantity FROM shipment_items WHERE shipment_id = ?", shipmentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*supplychain.OrderItem
+	for rows.Next() {
+		var item supplychain.OrderItem
+		if err := rows.Scan(&item.ItemId, &item.Quantity); err != nil {
+			return nil, err
+		}
+		items = append(items, &item)
+	}
+	return items, rows.Err()
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Scrimzay/supplychain/supplychain"
+)
+
+// pick is the order a pick session is working on and what has been picked
+// for it so far, it only lives as long as the session
+type pick struct {
+	orderID string
+	lines   []*supplychain.PickLine
+}
+
+// startPick loads the lines of an order that are still to be fulfilled. An
+// order that can't be fulfilled gives the same error FulfillOrder would.
+func startPick(ctx context.Context, q queryer, orderID string) (*pick, error) {
+	var current string
+	err := q.QueryRowContext(ctx, "SELECT status FROM orders WHERE id = ?", orderID).Scan(&current)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "Order not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to check order")
+	}
+	if !canTransitionOrder(current, orderFulfilled) {
+		return nil, orderTransitionError(current, orderFulfilled)
+	}
+
+	rows, err := q.QueryContext(ctx,
+		"SELECT item_id, COALESCE(item_name, ''), quantity - fulfilled_quantity FROM order_items WHERE order_id = ? AND quantity > fulfilled_quantity",
+		orderID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch order items")
+	}
+	defer rows.Close()
+
+	p := &pick{orderID: orderID}
+	for rows.Next() {
+		line := &supplychain.PickLine{}
+		if err := rows.Scan(&line.ItemId, &line.ItemName, &line.Quantity); err != nil {
+			return nil, status.Error(codes.Internal, "Failed to scan order items")
+		}
+		p.lines = append(p.lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch order items")
+	}
+	if len(p.lines) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "Nothing left to pick on this order")
+	}
+	return p, nil
+}
+
+// scan counts units of an item towards its line, a scan that doesn't fit
+// the order isn't counted
+func (p *pick) scan(itemID string, quantity int32) *supplychain.PickResponse {
+	for _, line := range p.lines {
+		if line.ItemId != itemID {
+			continue
+		}
+		if line.Picked+quantity > line.Quantity {
+			return p.response(supplychain.PickStatus_PICK_STATUS_OVER_PICK,
+				fmt.Sprintf("Only %d more %s needed, put %d back", line.Quantity-line.Picked, line.ItemName, quantity))
+		}
+		line.Picked += quantity
+		return p.response(supplychain.PickStatus_PICK_STATUS_PICKED,
+			fmt.Sprintf("%d of %d %s picked", line.Picked, line.Quantity, line.ItemName))
+	}
+	return p.response(supplychain.PickStatus_PICK_STATUS_WRONG_ITEM, "Item "+itemID+" isn't left to pick on this order")
+}
+
+// done reports whether every line is picked
+func (p *pick) done() bool {
+	for _, line := range p.lines {
+		if line.Picked < line.Quantity {
+			return false
+		}
+	}
+	return true
+}
+
+// response reports a scan along with the progress of the pick, p may be nil
+// before an order is scanned
+func (p *pick) response(pickStatus supplychain.PickStatus, msg string) *supplychain.PickResponse {
+	resp := &supplychain.PickResponse{Status: pickStatus, Message: msg}
+	if p != nil {
+		resp.OrderId = p.orderID
+		resp.Lines = p.lines
+	}
+	return resp
+}
+
+// PickSession checks a handheld's scans against the order being picked as
+// they come in. Once every line is picked the order is fulfilled, if that
+// fails the pick is dropped and the order has to be scanned again.
+func (s *SupplyChainServer) PickSession(stream grpc.BidiStreamingServer[supplychain.PickRequest, supplychain.PickResponse]) error {
+	ctx := stream.Context()
+
+	var current *pick
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var resp *supplychain.PickResponse
+		switch {
+		case req.OrderId != "" && req.ItemId != "":
+			resp = current.response(supplychain.PickStatus_PICK_STATUS_REJECTED, "Scan an order or an item, not both")
+
+		case req.OrderId != "":
+			next, err := startPick(ctx, s.db, req.OrderId)
+			if status.Code(err) == codes.Internal {
+				return err
+			}
+			if err != nil {
+				resp = current.response(supplychain.PickStatus_PICK_STATUS_REJECTED, status.Convert(err).Message())
+				break
+			}
+			current = next
+			resp = current.response(supplychain.PickStatus_PICK_STATUS_STARTED, "Order ready to pick")
+
+		case req.ItemId != "":
+			quantity := req.Quantity
+			if quantity == 0 {
+				quantity = 1
+			}
+			if current == nil {
+				resp = current.response(supplychain.PickStatus_PICK_STATUS_REJECTED, "Scan an order first")
+				break
+			}
+			if quantity < 0 {
+				resp = current.response(supplychain.PickStatus_PICK_STATUS_REJECTED, "Quantity can't be negative")
+				break
+			}
+			resp = current.scan(req.ItemId, quantity)
+			if resp.Status != supplychain.PickStatus_PICK_STATUS_PICKED || !current.done() {
+				break
+			}
+
+			order, _, err := s.fulfillOrder(ctx, current.orderID, supplychain.FulfillmentMode_FULFILLMENT_MODE_ALL_OR_NOTHING)
+			if status.Code(err) == codes.Internal {
+				return err
+			}
+			if err != nil {
+				resp = current.response(supplychain.PickStatus_PICK_STATUS_FAILED, status.Convert(err).Message())
+				for _, detail := range status.Convert(err).Details() {
+					if stock, ok := detail.(*supplychain.InsufficientStock); ok {
+						resp.Shortfalls = stock.Lines
+					}
+				}
+			} else {
+				resp = current.response(supplychain.PickStatus_PICK_STATUS_COMPLETED, "Order fulfilled")
+				resp.Order = order
+			}
+			current = nil
+
+		default:
+			resp = current.response(supplychain.PickStatus_PICK_STATUS_REJECTED, "Scan an order or an item")
+		}
+
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// Package policy decides what a role may do. Permissions are written
+// resource:action, e.g. "orders:fulfill", a role can also be granted every
+// action on a resource ("orders:*") or everything ("*").
+package policy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync/atomic"
+)
+
+// Role is a named set of permissions
+type Role struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+// Policy is the set of roles in force. It is never changed once built, a
+// new policy replaces it instead.
+type Policy struct {
+	roles map[string]*Role
+	// grants is role -> granted permissions, wildcards included
+	grants map[string]map[string]bool
+}
+
+var (
+	permissionPattern = regexp.MustCompile(`^(\*|[a-z_]+:(\*|[a-z_]+))$`)
+	rolePattern       = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+)
+
+// ValidPermission reports whether a permission is written resource:action,
+// resource:* or *
+func ValidPermission(permission string) bool {
+	return permissionPattern.MatchString(permission)
+}
+
+// ValidRoleName reports whether a role name is usable, lower case letters,
+// digits, - and _
+func ValidRoleName(name string) bool {
+	return rolePattern.MatchString(name)
+}
+
+// New checks a set of roles and builds a policy from them
+func New(roles []*Role) (*Policy, error) {
+	p := &Policy{roles: map[string]*Role{}, grants: map[string]map[string]bool{}}
+	for _, role := range roles {
+		if !ValidRoleName(role.Name) {
+			return nil, fmt.Errorf("invalid role name %q", role.Name)
+		}
+		if _, dup := p.roles[role.Name]; dup {
+			return nil, fmt.Errorf("role %s listed twice", role.Name)
+		}
+		grants := map[string]bool{}
+		for _, permission := range role.Permissions {
+			if !ValidPermission(permission) {
+				return nil, fmt.Errorf("role %s: invalid permission %q", role.Name, permission)
+			}
+			grants[permission] = true
+		}
+		p.roles[role.Name] = role
+		p.grants[role.Name] = grants
+	}
+	return p, nil
+}
+
+// Allows reports whether a role has a permission
+func (p *Policy) Allows(role, permission string) bool {
+	grants, ok := p.grants[role]
+	if !ok {
+		return false
+	}
+	if grants["*"] || grants[permission] {
+		return true
+	}
+	resource, _, _ := strings.Cut(permission, ":")
+	return grants[resource+":*"]
+}
+
+// HasRole reports whether a role exists
+func (p *Policy) HasRole(name string) bool {
+	_, ok := p.roles[name]
+	return ok
+}
+
+// Roles returns every role, sorted by name
+func (p *Policy) Roles() []*Role {
+	roles := make([]*Role, 0, len(p.roles))
+	for _, role := range p.roles {
+		roles = append(roles, role)
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Name < roles[j].Name })
+	return roles
+}
+
+// policyFile is the layout of a policy file
+type policyFile struct {
+	Roles []*Role `json:"roles"`
+}
+
+// LoadFile reads a policy file like
+//
+//	{"roles": [
+//		{"name": "admin", "permissions": ["*"]},
+//		{"name": "clerk", "description": "Warehouse clerk", "permissions": ["orders:read", "orders:fulfill", "shipments:*"]}
+//	]}
+func LoadFile(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file policyFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if len(file.Roles) == 0 {
+		return nil, fmt.Errorf("%s: %w", path, errors.New("no roles"))
+	}
+	p, err := New(file.Roles)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return p, nil
+}
+
+// Holder hands out the policy in force and lets it be swapped while
+// requests are being checked against it
+type Holder struct {
+	current atomic.Pointer[Policy]
+}
+
+// NewHolder returns a holder starting out with a policy
+func NewHolder(p *Policy) *Holder {
+	h := &Holder{}
+	h.current.Store(p)
+	return h
+}
+
+// Load returns the policy in force
+func (h *Holder) Load() *Policy {
+	return h.current.Load()
+}
+
+// Store puts a new policy in force
+func (h *Holder) Store(p *Policy) {
+	h.current.Store(p)
+}
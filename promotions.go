@@ -0,0 +1,537 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Scrimzay/supplychain/money"
+	"github.com/Scrimzay/supplychain/supplychain"
+)
+
+// couponCode normalizes a coupon code as given by an admin or a customer
+func couponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// parsePercent reads a percentage between 0 (exclusive) and 100
+func parsePercent(s string) (*big.Rat, bool) {
+	pct, ok := new(big.Rat).SetString(s)
+	if !ok || pct.Sign() <= 0 || pct.Cmp(big.NewRat(100, 1)) > 0 {
+		return nil, false
+	}
+	return pct, true
+}
+
+// validatePromotion checks that a new promotion carries what its type needs
+func validatePromotion(p *supplychain.Promotion) error {
+	if p.Name == "" {
+		return status.Error(codes.InvalidArgument, "Promotion name required")
+	}
+	if p.EndsAt != 0 && p.EndsAt <= p.StartsAt {
+		return status.Error(codes.InvalidArgument, "Promotion ends before it starts")
+	}
+	if p.UsageLimit < 0 {
+		return status.Error(codes.InvalidArgument, "Invalid usage limit")
+	}
+
+	switch p.Type {
+	case supplychain.PromotionType_PROMOTION_TYPE_PERCENTAGE:
+		if _, ok := parsePercent(p.PercentOff); !ok {
+			return status.Errorf(codes.InvalidArgument, "Invalid percent_off %q", p.PercentOff)
+		}
+	case supplychain.PromotionType_PROMOTION_TYPE_FIXED_AMOUNT:
+		if p.AmountOff == nil || p.AmountOff.Value <= 0 {
+			return status.Error(codes.InvalidArgument, "Fixed amount promotions need a positive amount_off")
+		}
+		if !money.Valid(p.AmountOff.Currency) {
+			return status.Errorf(codes.InvalidArgument, "Unknown currency code %q", p.AmountOff.Currency)
+		}
+	case supplychain.PromotionType_PROMOTION_TYPE_BUY_X_GET_Y:
+		if p.BuyQuantity <= 0 || p.GetQuantity <= 0 {
+			return status.Error(codes.InvalidArgument, "Buy X get Y promotions need positive buy_quantity and get_quantity")
+		}
+	case supplychain.PromotionType_PROMOTION_TYPE_TIERED_VOLUME:
+		if len(p.Tiers) == 0 {
+			return status.Error(codes.InvalidArgument, "Tiered promotions need at least one tier")
+		}
+		seen := map[int32]bool{}
+		for _, tier := range p.Tiers {
+			if tier.MinQuantity <= 0 || seen[tier.MinQuantity] {
+				return status.Errorf(codes.InvalidArgument, "Invalid tier minimum %d", tier.MinQuantity)
+			}
+			if _, ok := parsePercent(tier.PercentOff); !ok {
+				return status.Errorf(codes.InvalidArgument, "Invalid tier percent_off %q", tier.PercentOff)
+			}
+			seen[tier.MinQuantity] = true
+		}
+	default:
+		return status.Error(codes.InvalidArgument, "Unknown promotion type")
+	}
+	return nil
+}
+
+// promotionLive reports whether a promotion can be used at a unix time
+func promotionLive(p *supplychain.Promotion, now int64) bool {
+	return p.Active && p.StartsAt <= now && (p.EndsAt == 0 || now < p.EndsAt) &&
+		(p.UsageLimit == 0 || p.UsageCount < p.UsageLimit)
+}
+
+// promotionDiscounts works out what a promotion takes off each order line.
+// remaining is what is left of every line after earlier promotions, no
+// discount goes beyond it.
+func promotionDiscounts(p *supplychain.Promotion, lines []*supplychain.OrderItem, remaining []int64) []int64 {
+	eligible := make([]bool, len(lines))
+	for i, line := range lines {
+		eligible[i] = len(p.ItemIds) == 0
+		for _, itemID := range p.ItemIds {
+			if itemID == line.ItemId {
+				eligible[i] = true
+			}
+		}
+	}
+
+	discounts := make([]int64, len(lines))
+	percentOf := func(amount int64, percent string) int64 {
+		pct, ok := parsePercent(percent)
+		if !ok {
+			return 0
+		}
+		off, err := money.Round(new(big.Rat).Mul(new(big.Rat).SetInt64(amount), new(big.Rat).Quo(pct, big.NewRat(100, 1))))
+		if err != nil {
+			return 0
+		}
+		return off
+	}
+
+	switch p.Type {
+	case supplychain.PromotionType_PROMOTION_TYPE_PERCENTAGE:
+		for i := range lines {
+			if eligible[i] {
+				discounts[i] = percentOf(remaining[i], p.PercentOff)
+			}
+		}
+
+	case supplychain.PromotionType_PROMOTION_TYPE_TIERED_VOLUME:
+		tiers := append([]*supplychain.DiscountTier(nil), p.Tiers...)
+		sort.Slice(tiers, func(a, b int) bool { return tiers[a].MinQuantity > tiers[b].MinQuantity })
+		for i, line := range lines {
+			if !eligible[i] {
+				continue
+			}
+			for _, tier := range tiers {
+				if line.Quantity >= tier.MinQuantity {
+					discounts[i] = percentOf(remaining[i], tier.PercentOff)
+					break
+				}
+			}
+		}
+
+	case supplychain.PromotionType_PROMOTION_TYPE_BUY_X_GET_Y:
+		for i, line := range lines {
+			if eligible[i] {
+				free := line.Quantity / (p.BuyQuantity + p.GetQuantity) * p.GetQuantity
+				discounts[i] = int64(free) * line.UnitPrice.Value
+			}
+		}
+
+	case supplychain.PromotionType_PROMOTION_TYPE_FIXED_AMOUNT:
+		// the amount is split over the eligible lines by what is left of
+		// them, rounding down, and the pennies left over go one at a time
+		// to the first lines with room
+		var base int64
+		for i := range lines {
+			if eligible[i] {
+				base += remaining[i]
+			}
+		}
+		if base == 0 || lines[0].UnitPrice.Currency != p.AmountOff.Currency {
+			return discounts
+		}
+		off := min(p.AmountOff.Value, base)
+		var split int64
+		for i := range lines {
+			if eligible[i] {
+				share := new(big.Int).Mul(big.NewInt(off), big.NewInt(remaining[i]))
+				discounts[i] = share.Quo(share, big.NewInt(base)).Int64()
+				split += discounts[i]
+			}
+		}
+		for i := 0; split < off; i = (i + 1) % len(lines) {
+			if eligible[i] && discounts[i] < remaining[i] {
+				discounts[i]++
+				split++
+			}
+		}
+	}
+
+	for i := range discounts {
+		discounts[i] = min(max(discounts[i], 0), remaining[i])
+	}
+	return discounts
+}
+
+// discountOrder applies the automatic promotions and the given coupons to a
+// new order's lines in the order the promotions were created. A coupon that is unknown,
+// not live or doesn't take anything off fails the order.
+func discountOrder(ctx context.Context, tx *sql.Tx, order *supplychain.Order, coupons []string, now int64) error {
+	query := "SELECT id FROM promotions WHERE (code IS NULL AND active = 1)"
+	args := []interface{}{}
+	seen := map[string]bool{}
+	for _, code := range coupons {
+		code = couponCode(code)
+		if code == "" || seen[code] {
+			continue
+		}
+		seen[code] = true
+
+		var id string
+		err := tx.QueryRowContext(ctx, "SELECT id FROM promotions WHERE code = ?", code).Scan(&id)
+		if err == sql.ErrNoRows {
+			return status.Errorf(codes.InvalidArgument, "Unknown coupon code %q", code)
+		}
+		if err != nil {
+			return status.Error(codes.Internal, "Failed to check coupon")
+		}
+		query += " OR id = ?"
+		args = append(args, id)
+	}
+	query += " ORDER BY rowid"
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return status.Error(codes.Internal, "Failed to fetch promotions")
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return status.Error(codes.Internal, "Failed to scan promotions")
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+
+	var promotions []*supplychain.Promotion
+	for _, id := range ids {
+		promotion, err := loadPromotion(ctx, tx, id)
+		if err != nil {
+			return status.Error(codes.Internal, "Failed to fetch promotion")
+		}
+		if !promotionLive(promotion, now) {
+			if promotion.Code != "" {
+				return status.Errorf(codes.FailedPrecondition, "Coupon %q is not valid now", promotion.Code)
+			}
+			continue
+		}
+		promotions = append(promotions, promotion)
+	}
+
+	currency := order.Total.Currency
+	remaining := make([]int64, len(order.Items))
+	for i, item := range order.Items {
+		remaining[i] = item.LineTotal.Value
+	}
+	var discountTotal int64
+	for _, promotion := range promotions {
+		discounts := promotionDiscounts(promotion, order.Items, remaining)
+		var applied int64
+		for i, amount := range discounts {
+			if amount == 0 {
+				continue
+			}
+			remaining[i] -= amount
+			applied += amount
+			order.Items[i].Discounts = append(order.Items[i].Discounts, &supplychain.LineDiscount{
+				PromotionId: promotion.Id,
+				Name:        promotion.Name,
+				Code:        promotion.Code,
+				Amount:      formatAmount(ctx, &supplychain.Amount{Value: amount, Currency: currency}),
+			})
+		}
+		if applied == 0 {
+			if promotion.Code != "" {
+				return status.Errorf(codes.FailedPrecondition, "Coupon %q does not apply to this order", promotion.Code)
+			}
+			continue
+		}
+		if promotion.Code != "" {
+			order.CouponCodes = append(order.CouponCodes, promotion.Code)
+		}
+		discountTotal += applied
+	}
+
+	for i, item := range order.Items {
+		item.Discount = formatAmount(ctx, &supplychain.Amount{Value: item.LineTotal.Value - remaining[i], Currency: currency})
+	}
+	order.DiscountTotal = formatAmount(ctx, &supplychain.Amount{Value: discountTotal, Currency: currency})
+	return nil
+}
+
+// recordDiscounts stores the promotions applied to a new order and counts
+// the order against their usage limits
+func recordDiscounts(ctx context.Context, tx *sql.Tx, order *supplychain.Order) error {
+	used := map[string]bool{}
+	for _, item := range order.Items {
+		for _, discount := range item.Discounts {
+			_, err := tx.ExecContext(ctx,
+				"INSERT INTO order_discounts (order_id, item_id, promotion_id, amount_value) VALUES (?, ?, ?, ?)",
+				order.Id, item.ItemId, discount.PromotionId, discount.Amount.Value)
+			if err != nil {
+				return status.Error(codes.Internal, "Failed to record discounts")
+			}
+			used[discount.PromotionId] = true
+		}
+	}
+	for id := range used {
+		result, err := tx.ExecContext(ctx,
+			"UPDATE promotions SET usage_count = usage_count + 1 WHERE id = ? AND (usage_limit = 0 OR usage_count < usage_limit)", id)
+		if err != nil {
+			return status.Error(codes.Internal, "Failed to update promotion")
+		}
+		if n, _ := result.RowsAffected(); n != 1 {
+			return status.Error(codes.Aborted, "Promotion used up concurrently")
+		}
+	}
+	return nil
+}
+
+// releasePromotions gives the uses of a cancelled order back to its promotions
+func releasePromotions(ctx context.Context, tx *sql.Tx, orderID string) error {
+	_, err := tx.ExecContext(ctx,
+		"UPDATE promotions SET usage_count = MAX(usage_count - 1, 0) WHERE id IN (SELECT DISTINCT promotion_id FROM order_discounts WHERE order_id = ?)",
+		orderID)
+	return err
+}
+
+// loadOrderDiscounts attaches the promotions applied to an order to its lines
+func loadOrderDiscounts(ctx context.Context, q queryer, order *supplychain.Order) error {
+	rows, err := q.QueryContext(ctx, `
+		SELECT d.item_id, d.promotion_id, p.name, COALESCE(p.code, ''), d.amount_value
+		FROM order_discounts d JOIN promotions p ON p.id = d.promotion_id
+		WHERE d.order_id = ? ORDER BY p.rowid`, order.Id)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	lines := map[string]*supplychain.OrderItem{}
+	for _, item := range order.Items {
+		lines[item.ItemId] = item
+	}
+	coupons := map[string]bool{}
+	for rows.Next() {
+		var itemID string
+		var amount int64
+		discount := &supplychain.LineDiscount{}
+		if err := rows.Scan(&itemID, &discount.PromotionId, &discount.Name, &discount.Code, &amount); err != nil {
+			return err
+		}
+		discount.Amount = formatAmount(ctx, &supplychain.Amount{Value: amount, Currency: order.Total.Currency})
+		if line, ok := lines[itemID]; ok {
+			line.Discounts = append(line.Discounts, discount)
+		}
+		if discount.Code != "" && !coupons[discount.Code] {
+			coupons[discount.Code] = true
+			order.CouponCodes = append(order.CouponCodes, discount.Code)
+		}
+	}
+	return rows.Err()
+}
+
+// orderLineDiscount returns the share of an order line's discount that falls
+// on quantity of its units
+func orderLineDiscount(ctx context.Context, tx *sql.Tx, orderID, itemID string, quantity int32) (int64, error) {
+	var discount sql.NullInt64
+	var lineQuantity int32
+	err := tx.QueryRowContext(ctx,
+		"SELECT discount_value, quantity FROM order_items WHERE order_id = ? AND item_id = ?",
+		orderID, itemID).Scan(&discount, &lineQuantity)
+	if err != nil {
+		return 0, err
+	}
+	if !discount.Valid || lineQuantity == 0 {
+		return 0, nil
+	}
+	return money.Round(big.NewRat(discount.Int64*int64(quantity), int64(lineQuantity)))
+}
+
+func (s *SupplyChainServer) CreatePromotion(ctx context.Context, req *supplychain.CreatePromotionRequest) (*supplychain.CreatePromotionResponse, error) {
+	p := req.Promotion
+	if p == nil {
+		return nil, status.Error(codes.InvalidArgument, "Promotion required")
+	}
+	if err := validatePromotion(p); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	var code interface{}
+	if c := couponCode(p.Code); c != "" {
+		var exists int
+		err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM promotions WHERE code = ?", c).Scan(&exists)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Failed to check coupon")
+		}
+		if exists > 0 {
+			return nil, status.Errorf(codes.AlreadyExists, "Coupon code %q is taken", c)
+		}
+		code = c
+	}
+	var amountValue, amountCurrency interface{}
+	if p.Type == supplychain.PromotionType_PROMOTION_TYPE_FIXED_AMOUNT {
+		amountValue, amountCurrency = p.AmountOff.Value, p.AmountOff.Currency
+	}
+
+	id := uuid.New().String()
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO promotions (id, name, type, code, percent_off, amount_off_value, amount_off_currency, buy_quantity, get_quantity,
+		starts_at, ends_at, usage_limit, usage_count, active, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 0, 1, ?)`,
+		id, p.Name, p.Type.String(), code, p.PercentOff, amountValue, amountCurrency, p.BuyQuantity, p.GetQuantity,
+		p.StartsAt, p.EndsAt, p.UsageLimit, time.Now().Unix())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to create promotion")
+	}
+	for _, itemID := range p.ItemIds {
+		if _, err := tx.ExecContext(ctx, "INSERT OR IGNORE INTO promotion_items (promotion_id, item_id) VALUES (?, ?)", id, itemID); err != nil {
+			return nil, status.Error(codes.Internal, "Failed to add promotion items")
+		}
+	}
+	for _, tier := range p.Tiers {
+		_, err := tx.ExecContext(ctx, "INSERT INTO promotion_tiers (promotion_id, min_quantity, percent_off) VALUES (?, ?, ?)",
+			id, tier.MinQuantity, tier.PercentOff)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Failed to add promotion tiers")
+		}
+	}
+
+	promotion, err := loadPromotion(ctx, tx, id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch promotion")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to commit transaction")
+	}
+
+	return &supplychain.CreatePromotionResponse{Promotion: promotion}, nil
+}
+
+func (s *SupplyChainServer) ListPromotions(ctx context.Context, req *supplychain.ListPromotionsRequest) (*supplychain.ListPromotionsResponse, error) {
+	query := "SELECT id FROM promotions"
+	if !req.IncludeInactive {
+		query += " WHERE active = 1"
+	}
+	query += " ORDER BY rowid"
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to list promotions")
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, status.Error(codes.Internal, "Failed to scan promotions")
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+
+	resp := &supplychain.ListPromotionsResponse{}
+	for _, id := range ids {
+		promotion, err := loadPromotion(ctx, s.db, id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Failed to fetch promotion")
+		}
+		resp.Promotions = append(resp.Promotions, promotion)
+	}
+
+	return resp, nil
+}
+
+func (s *SupplyChainServer) DeactivatePromotion(ctx context.Context, req *supplychain.DeactivatePromotionRequest) (*supplychain.DeactivatePromotionResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "Promotion ID required")
+	}
+
+	result, err := s.db.ExecContext(ctx, "UPDATE promotions SET active = 0 WHERE id = ?", req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to deactivate promotion")
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return nil, status.Error(codes.NotFound, "Promotion not found")
+	}
+
+	promotion, err := loadPromotion(ctx, s.db, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch promotion")
+	}
+
+	return &supplychain.DeactivatePromotionResponse{Promotion: promotion}, nil
+}
+
+// loadPromotion reads a promotion with its items and tiers, it returns sql.ErrNoRows for unknown ids
+func loadPromotion(ctx context.Context, q queryer, id string) (*supplychain.Promotion, error) {
+	var p supplychain.Promotion
+	var promotionType string
+	var code, percentOff, amountCurrency sql.NullString
+	var amountValue sql.NullInt64
+	err := q.QueryRowContext(ctx, `
+		SELECT id, name, type, code, percent_off, amount_off_value, amount_off_currency, buy_quantity, get_quantity,
+		starts_at, ends_at, usage_limit, usage_count, active, created_at FROM promotions WHERE id = ?`,
+		id).Scan(&p.Id, &p.Name, &promotionType, &code, &percentOff, &amountValue, &amountCurrency, &p.BuyQuantity, &p.GetQuantity,
+		&p.StartsAt, &p.EndsAt, &p.UsageLimit, &p.UsageCount, &p.Active, &p.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	p.Type = supplychain.PromotionType(supplychain.PromotionType_value[promotionType])
+	p.Code = code.String
+	p.PercentOff = percentOff.String
+	if amountValue.Valid {
+		p.AmountOff = formatAmount(ctx, &supplychain.Amount{Value: amountValue.Int64, Currency: amountCurrency.String})
+	}
+
+	rows, err := q.QueryContext(ctx, "SELECT item_id FROM promotion_items WHERE promotion_id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var itemID string
+		if err := rows.Scan(&itemID); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		p.ItemIds = append(p.ItemIds, itemID)
+	}
+	rows.Close()
+
+	rows, err = q.QueryContext(ctx, "SELECT min_quantity, percent_off FROM promotion_tiers WHERE promotion_id = ? ORDER BY min_quantity", id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var tier supplychain.DiscountTier
+		if err := rows.Scan(&tier.MinQuantity, &tier.PercentOff); err != nil {
+			return nil, err
+		}
+		p.Tiers = append(p.Tiers, &tier)
+	}
+	return &p, rows.Err()
+}
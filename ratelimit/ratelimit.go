@@ -0,0 +1,198 @@
+// Package ratelimit keeps callers to a request rate with token buckets.
+// Limits are set per role and per caller, with overrides for single
+// methods; daily quotas are counted by the caller of the package since they
+// have to survive restarts.
+package ratelimit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"sync"
+	"time"
+)
+
+// Limit is a token bucket refilled at Rate requests a second holding at most
+// Burst, zero values mean unlimited
+type Limit struct {
+	Rate       float64 `json:"rate"`
+	Burst      int     `json:"burst"`
+	DailyQuota int64   `json:"daily_quota"`
+}
+
+// Rules are the limits of a role or caller
+type Rules struct {
+	Limit
+	// Methods have buckets and quotas of their own, keyed by method name
+	// like "CreateOrder"
+	Methods map[string]Limit `json:"methods"`
+}
+
+// Config is the layout of a rate limit file, like
+//
+//	{"default": {"rate": 20, "burst": 40},
+//	 "roles": {"customer": {"rate": 5, "burst": 10, "daily_quota": 5000,
+//		"methods": {"CreateOrder": {"rate": 0.5, "burst": 3, "daily_quota": 200}}}},
+//	 "callers": {"dev-customer": {"rate": 50, "burst": 100}}}
+//
+// A caller's own rules win over its role's, which win over the default.
+// Callers are named like in the audit log: a key id, jwt:<subject> or
+// cert:<common name>.
+type Config struct {
+	Default *Rules            `json:"default"`
+	Roles   map[string]*Rules `json:"roles"`
+	Callers map[string]*Rules `json:"callers"`
+}
+
+// LoadFile reads a rate limit file
+func LoadFile(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := config.check(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &config, nil
+}
+
+func (c *Config) check() error {
+	for _, rules := range c.all() {
+		if rules == nil {
+			return errors.New("rules can't be null")
+		}
+		if err := rules.Limit.check(); err != nil {
+			return err
+		}
+		for method, limit := range rules.Methods {
+			if err := limit.check(); err != nil {
+				return fmt.Errorf("%s: %w", method, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (l Limit) check() error {
+	if l.Rate < 0 || l.Burst < 0 || l.DailyQuota < 0 {
+		return errors.New("limits can't be negative")
+	}
+	if l.Rate > 0 && l.Burst == 0 {
+		return errors.New("a rate needs a burst of at least 1")
+	}
+	return nil
+}
+
+// MethodNames returns every method named in the config, for checking them
+func (c *Config) MethodNames() []string {
+	var names []string
+	for _, rules := range c.all() {
+		for method := range rules.Methods {
+			names = append(names, method)
+		}
+	}
+	return names
+}
+
+func (c *Config) all() []*Rules {
+	var all []*Rules
+	if c.Default != nil {
+		all = append(all, c.Default)
+	}
+	for _, rules := range c.Roles {
+		all = append(all, rules)
+	}
+	for _, rules := range c.Callers {
+		all = append(all, rules)
+	}
+	return all
+}
+
+// Rule is the limit a call falls under. Calls sharing a scope share a bucket
+// and a quota, the scope is "*" unless the method has a limit of its own.
+type Rule struct {
+	Limit
+	Scope string
+}
+
+// Lookup returns the rule for a caller calling a method, ok is false when
+// nothing limits the call
+func (c *Config) Lookup(caller, role, method string) (rule Rule, ok bool) {
+	for _, rules := range []*Rules{c.Callers[caller], c.Roles[role], c.Default} {
+		if rules == nil {
+			continue
+		}
+		if limit, found := rules.Methods[method]; found {
+			return Rule{Limit: limit, Scope: method}, true
+		}
+		return Rule{Limit: rules.Limit, Scope: "*"}, true
+	}
+	return Rule{}, false
+}
+
+// bucket is a token bucket, tokens are topped up when it is used
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Limiter holds a bucket per caller and scope
+type Limiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// NewLimiter returns a limiter with every bucket full
+func NewLimiter() *Limiter {
+	return &Limiter{buckets: map[string]*bucket{}, lastSweep: time.Now()}
+}
+
+// sweepEvery is how often buckets that have filled up again are dropped,
+// a full bucket is the same as no bucket
+const sweepEvery = 10 * time.Minute
+
+// Allow takes a token from the caller's bucket for the rule. When the
+// bucket is empty it returns false and how long until a token is back.
+func (l *Limiter) Allow(caller string, rule Rule, now time.Time) (bool, time.Duration) {
+	if rule.Rate <= 0 {
+		return true, 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) > sweepEvery {
+		l.sweep(now)
+	}
+	key := caller + "|" + rule.Scope
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(rule.Burst), last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = math.Min(float64(rule.Burst), b.tokens+now.Sub(b.last).Seconds()*rule.Rate)
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	wait := time.Duration((1 - b.tokens) / rule.Rate * float64(time.Second))
+	return false, wait
+}
+
+// sweep drops buckets idle long enough to have filled up with any rate
+// worth limiting to
+func (l *Limiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if now.Sub(b.last) > sweepEvery {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
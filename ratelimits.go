@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/Scrimzay/supplychain/db"
+	"github.com/Scrimzay/supplychain/ratelimit"
+)
+
+// quotaDays is how many days of quota usage are kept
+const quotaDays = 7
+
+// rateLimits applies the rate limits and daily quotas of a config, a nil
+// *rateLimits limits nothing
+type rateLimits struct {
+	config  *ratelimit.Config
+	limiter *ratelimit.Limiter
+	db      *db.DatabaseStruct
+}
+
+// newRateLimits loads a rate limit file, every method it names must exist
+func newRateLimits(store *db.DatabaseStruct, path string) (*rateLimits, error) {
+	config, err := ratelimit.LoadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, method := range config.MethodNames() {
+		if _, ok := methodPermissions["/supplychain.SupplyChain/"+method]; !ok {
+			return nil, fmt.Errorf("%s: unknown method %q", path, method)
+		}
+	}
+	return &rateLimits{config: config, limiter: ratelimit.NewLimiter(), db: store}, nil
+}
+
+// check takes a call off the caller's bucket and quota. Over the limit it
+// returns ResourceExhausted and sets a retry-after header in seconds.
+func (r *rateLimits) check(ctx context.Context, caller *principal, fullMethod string) error {
+	if r == nil {
+		return nil
+	}
+	method := strings.TrimPrefix(fullMethod, "/supplychain.SupplyChain/")
+	rule, ok := r.config.Lookup(caller.KeyID, caller.Role, method)
+	if !ok {
+		return nil
+	}
+
+	now := time.Now()
+	if allowed, wait := r.limiter.Allow(caller.KeyID, rule, now); !allowed {
+		return exhausted(ctx, wait, "Rate limit exceeded")
+	}
+	if rule.DailyQuota > 0 {
+		day := now.UTC().Format("2006-01-02")
+		allowed, err := r.db.UseQuota(caller.KeyID, rule.Scope, day, rule.DailyQuota)
+		if err != nil {
+			return status.Error(codes.Internal, "Failed to check quota")
+		}
+		if !allowed {
+			midnight := now.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+			return exhausted(ctx, midnight.Sub(now), "Daily quota used up")
+		}
+	}
+	return nil
+}
+
+// exhausted tells the caller to come back after a wait
+func exhausted(ctx context.Context, wait time.Duration, msg string) error {
+	seconds := int64(math.Ceil(wait.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.FormatInt(seconds, 10))); err != nil {
+		log.Printf("Failed to set retry-after: %v", err)
+	}
+	return status.Errorf(codes.ResourceExhausted, "%s, retry in %ds", msg, seconds)
+}
+
+// pruneQuotaUsage periodically drops quota counts of past days
+func pruneQuotaUsage(db *db.DatabaseStruct, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		before := time.Now().UTC().AddDate(0, 0, -quotaDays).Format("2006-01-02")
+		if _, err := db.PruneQuotaUsage(before); err != nil {
+			log.Printf("Failed to prune quota usage: %v", err)
+		}
+	}
+}
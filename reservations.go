@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Scrimzay/supplychain/db"
+	"github.com/Scrimzay/supplychain/supplychain"
+)
+
+// Reservation statuses
+const (
+	reservationActive   = "ACTIVE"
+	reservationConsumed = "CONSUMED"
+	reservationReleased = "RELEASED"
+)
+
+// How long an order holds its stock before the reservation lapses
+const reservationTTL = 72 * time.Hour
+
+// reservedColumn sums the unexpired active reservations against items.id,
+// it takes the current unix time as its only argument
+const reservedColumn = `COALESCE((SELECT SUM(r.quantity) FROM reservations r
+	WHERE r.item_id = items.id AND r.status = 'ACTIVE' AND r.expires_at > ?), 0)`
+
+// reservedByOthersColumn is reservedColumn minus one order's own holds,
+// it takes the current unix time and the order id as arguments
+const reservedByOthersColumn = `COALESCE((SELECT SUM(r.quantity) FROM reservations r
+	WHERE r.item_id = items.id AND r.status = 'ACTIVE' AND r.expires_at > ? AND r.order_id != ?), 0)`
+
+// insufficientStockError builds a FailedPrecondition error carrying every short line
+func insufficientStockError(msg string, lines []*supplychain.StockShortfall) error {
+	st, err := status.New(codes.FailedPrecondition, msg).WithDetails(&supplychain.InsufficientStock{Lines: lines})
+	if err != nil {
+		return status.Error(codes.FailedPrecondition, msg)
+	}
+	return st.Err()
+}
+
+// setAvailability fills in the reserved and available quantities of an item
+func setAvailability(item *supplychain.Item, reserved int32) *supplychain.Item {
+	item.ReservedQuantity = reserved
+	item.AvailableQuantity = item.Quantity - reserved
+	if item.AvailableQuantity < 0 {
+		item.AvailableQuantity = 0
+	}
+	return item
+}
+
+// reserveStock holds quantity units of an item for an order
+func reserveStock(ctx context.Context, tx *sql.Tx, orderID, itemID string, quantity int32, now time.Time) error {
+	_, err := tx.ExecContext(ctx,
+		"INSERT INTO reservations (id, order_id, item_id, quantity, status, created_at, expires_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		uuid.New().String(), orderID, itemID, quantity, reservationActive, now.Unix(), now.Add(reservationTTL).Unix())
+	return err
+}
+
+// consumeReservation draws quantity units of an order line's reservation down
+// as its stock is taken out of inventory, a fully drawn reservation is consumed
+func consumeReservation(ctx context.Context, tx *sql.Tx, orderID, itemID string, quantity int32) error {
+	_, err := tx.ExecContext(ctx,
+		"UPDATE reservations SET quantity = MAX(quantity - ?, 0) WHERE order_id = ? AND item_id = ? AND status = ?",
+		quantity, orderID, itemID, reservationActive)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx,
+		"UPDATE reservations SET status = ? WHERE order_id = ? AND item_id = ? AND status = ? AND quantity = 0",
+		reservationConsumed, orderID, itemID, reservationActive)
+	return err
+}
+
+// releaseReservations gives back whatever stock an order still holds
+func releaseReservations(ctx context.Context, tx *sql.Tx, orderID string) error {
+	_, err := tx.ExecContext(ctx,
+		"UPDATE reservations SET status = ? WHERE order_id = ? AND status = ?",
+		reservationReleased, orderID, reservationActive)
+	return err
+}
+
+// expireReservations periodically lapses reservations that outlived their TTL
+func expireReservations(db *db.DatabaseStruct, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		n, err := db.ExpireReservations(time.Now().Unix())
+		if err != nil {
+			log.Printf("Failed to expire reservations: %v", err)
+			continue
+		}
+		if n > 0 {
+			log.Printf("Expired %d reservations", n)
+		}
+	}
+}
@@ -0,0 +1,380 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Scrimzay/supplychain/money"
+	"github.com/Scrimzay/supplychain/supplychain"
+)
+
+// Return statuses
+const (
+	returnRequested = "REQUESTED"
+	returnApproved  = "APPROVED"
+	returnRejected  = "REJECTED"
+	returnReceived  = "RECEIVED"
+)
+
+// Order event types for returns
+const (
+	orderEventReturnRequested = "RETURN_REQUESTED"
+	orderEventReturnApproved  = "RETURN_APPROVED"
+	orderEventReturnRejected  = "RETURN_REJECTED"
+	orderEventReturnReceived  = "RETURN_RECEIVED"
+)
+
+// Reasons a customer can give for returning a line
+var returnReasonCodes = map[string]bool{
+	"DAMAGED":          true,
+	"DEFECTIVE":        true,
+	"WRONG_ITEM":       true,
+	"NOT_AS_DESCRIBED": true,
+	"NO_LONGER_NEEDED": true,
+	"OTHER":            true,
+}
+
+// returnDispositions decides where returned goods go based on the condition
+// they arrive in, sellable goods go back on the shelf and the rest is held
+type returnDisposition struct {
+	Disposition string
+	Location    string
+}
+
+var returnDispositions = map[string]returnDisposition{
+	"NEW":       {"RESTOCKED", "INVENTORY"},
+	"OPENED":    {"RESTOCKED", "INVENTORY"},
+	"DAMAGED":   {"QUARANTINED", "QUARANTINE-DAMAGED"},
+	"DEFECTIVE": {"QUARANTINED", "QUARANTINE-DEFECTIVE"},
+}
+
+// orderLinePrice returns the unit price an order line was sold at. Lines of
+// orders placed before prices were snapshotted fall back to the item's current price.
+func orderLinePrice(ctx context.Context, tx *sql.Tx, orderID, itemID string) (int64, error) {
+	var price int64
+	err := tx.QueryRowContext(ctx, `
+		SELECT COALESCE(oi.unit_price_value, (SELECT i.unit_price_value FROM items i WHERE i.id = oi.item_id), 0)
+		FROM order_items oi WHERE oi.order_id = ? AND oi.item_id = ?`,
+		orderID, itemID).Scan(&price)
+	return price, err
+}
+
+func (s *SupplyChainServer) CreateReturn(ctx context.Context, req *supplychain.CreateReturnRequest) (*supplychain.CreateReturnResponse, error) {
+	if req.OrderId == "" || len(req.Items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid return details")
+	}
+	seen := map[string]bool{}
+	for _, item := range req.Items {
+		if item.ItemId == "" || item.Quantity <= 0 || seen[item.ItemId] {
+			return nil, status.Error(codes.InvalidArgument, "Invalid return details")
+		}
+		if !returnReasonCodes[item.ReasonCode] {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown reason code %q", item.ReasonCode)
+		}
+		seen[item.ItemId] = true
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	if err := checkOrderOwner(ctx, tx, req.OrderId); err != nil {
+		return nil, err
+	}
+
+	var orderStatus, currency string
+	err = tx.QueryRowContext(ctx, "SELECT status, total_currency FROM orders WHERE id = ?", req.OrderId).Scan(&orderStatus, &currency)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "Order not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to check order")
+	}
+	if orderStatus != orderDelivered && orderStatus != orderFulfilled {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot return order in status %s", orderStatus)
+	}
+
+	now := time.Now().Unix()
+	ret := &supplychain.Return{
+		Id:        uuid.New().String(),
+		OrderId:   req.OrderId,
+		Status:    returnRequested,
+		Reason:    req.Reason,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	var refundTotal int64
+	for _, item := range req.Items {
+		// lines can't be returned beyond what left the warehouse, less what
+		// earlier returns already claimed
+		var fulfilled, returned int32
+		err := tx.QueryRowContext(ctx, `
+			SELECT oi.fulfilled_quantity, COALESCE((SELECT SUM(ri.quantity) FROM return_items ri JOIN returns r ON r.id = ri.return_id
+				WHERE r.order_id = oi.order_id AND ri.item_id = oi.item_id AND r.status != ?), 0)
+			FROM order_items oi WHERE oi.order_id = ? AND oi.item_id = ?`,
+			returnRejected, req.OrderId, item.ItemId).Scan(&fulfilled, &returned)
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.InvalidArgument, "Item %s is not on the order", item.ItemId)
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Failed to check order items")
+		}
+		if returned+item.Quantity > fulfilled {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"Return exceeds fulfilled quantity for item %s: fulfilled %d, already returned %d, requested %d",
+				item.ItemId, fulfilled, returned, item.Quantity)
+		}
+
+		price, err := orderLinePrice(ctx, tx, req.OrderId, item.ItemId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Failed to price return")
+		}
+		// discounts come off the refund, tax charged on top of the price is refunded with it
+		lineTax, err := orderLineTax(ctx, tx, req.OrderId, item.ItemId, item.Quantity)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Failed to price return")
+		}
+		discount, err := orderLineDiscount(ctx, tx, req.OrderId, item.ItemId, item.Quantity)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Failed to price return")
+		}
+		refund := price*int64(item.Quantity) - discount + lineTax
+		refundTotal += refund
+
+		_, err = tx.ExecContext(ctx,
+			"INSERT INTO return_items (return_id, item_id, quantity, reason_code, refund_value) VALUES (?, ?, ?, ?, ?)",
+			ret.Id, item.ItemId, item.Quantity, item.ReasonCode, refund)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Failed to add return items")
+		}
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO returns (id, order_id, status, reason, refund_value, refund_currency, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		ret.Id, ret.OrderId, ret.Status, ret.Reason, refundTotal, currency, ret.CreatedAt, ret.UpdatedAt)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to create return")
+	}
+	if err := recordOrderEvent(ctx, tx, req.OrderId, orderEventReturnRequested, orderStatus, orderStatus, fmt.Sprintf("Return %s requested", ret.Id)); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to record order event")
+	}
+
+	ret, err = loadReturn(ctx, tx, ret.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch return")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to commit transaction")
+	}
+	s.orderUpdates.notify()
+
+	return &supplychain.CreateReturnResponse{Return: ret}, nil
+}
+
+func (s *SupplyChainServer) ApproveReturn(ctx context.Context, req *supplychain.ApproveReturnRequest) (*supplychain.ApproveReturnResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "Return ID required")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	var current, orderID, orderStatus string
+	err = tx.QueryRowContext(ctx,
+		"SELECT r.status, r.order_id, o.status FROM returns r JOIN orders o ON o.id = r.order_id WHERE r.id = ?",
+		req.Id).Scan(&current, &orderID, &orderStatus)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "Return not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to check return")
+	}
+	if current != returnRequested {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot review return in status %s", current)
+	}
+
+	next, eventType := returnApproved, orderEventReturnApproved
+	if !req.Approve {
+		next, eventType = returnRejected, orderEventReturnRejected
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE returns SET status = ?, updated_at = ? WHERE id = ?", next, time.Now().Unix(), req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to update return")
+	}
+	note := fmt.Sprintf("Return %s %s", req.Id, strings.ToLower(next))
+	if req.Note != "" {
+		note += ": " + req.Note
+	}
+	if err := recordOrderEvent(ctx, tx, orderID, eventType, orderStatus, orderStatus, note); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to record order event")
+	}
+
+	ret, err := loadReturn(ctx, tx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch return")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to commit transaction")
+	}
+	s.orderUpdates.notify()
+
+	return &supplychain.ApproveReturnResponse{Return: ret}, nil
+}
+
+func (s *SupplyChainServer) ReceiveReturn(ctx context.Context, req *supplychain.ReceiveReturnRequest) (*supplychain.ReceiveReturnResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "Return ID required")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	ret, err := loadReturn(ctx, tx, req.Id)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "Return not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch return")
+	}
+	if ret.Status != returnApproved {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot receive return in status %s", ret.Status)
+	}
+
+	conditions := map[string]string{}
+	for _, item := range req.Items {
+		conditions[item.ItemId] = item.Condition
+	}
+
+	now := time.Now().Unix()
+	for _, line := range ret.Items {
+		condition, ok := conditions[line.ItemId]
+		if !ok {
+			condition = req.Condition
+		}
+		disposition, ok := returnDispositions[condition]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown condition %q for item %s", condition, line.ItemId)
+		}
+
+		if disposition.Disposition == "RESTOCKED" {
+			_, err = tx.ExecContext(ctx, "UPDATE items SET quantity = quantity + ?, updated_at = ? WHERE id = ?", line.Quantity, now, line.ItemId)
+			if err == nil {
+				err = recordStockChange(ctx, tx, line.ItemId, line.Quantity, "ReceiveReturn "+ret.Id, now)
+			}
+		} else {
+			_, err = tx.ExecContext(ctx,
+				"INSERT INTO quarantine_stock (item_id, return_id, quantity, condition, location, received_at) VALUES (?, ?, ?, ?, ?, ?)",
+				line.ItemId, ret.Id, line.Quantity, condition, disposition.Location, now)
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Failed to put away returned goods")
+		}
+
+		_, err = tx.ExecContext(ctx,
+			"UPDATE return_items SET condition = ?, disposition = ?, location = ? WHERE return_id = ? AND item_id = ?",
+			condition, disposition.Disposition, disposition.Location, ret.Id, line.ItemId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Failed to update return items")
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE returns SET status = ?, updated_at = ? WHERE id = ?", returnReceived, now, ret.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to update return")
+	}
+
+	var orderStatus string
+	if err := tx.QueryRowContext(ctx, "SELECT status FROM orders WHERE id = ?", ret.OrderId).Scan(&orderStatus); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to check order")
+	}
+	note := fmt.Sprintf("Return %s received, refund %s %s", ret.Id,
+		money.Format(ret.RefundTotal.Value, ret.RefundTotal.Currency, money.DefaultLocale), ret.RefundTotal.Currency)
+	if err := recordOrderEvent(ctx, tx, ret.OrderId, orderEventReturnReceived, orderStatus, orderStatus, note); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to record order event")
+	}
+
+	ret, err = loadReturn(ctx, tx, ret.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch return")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to commit transaction")
+	}
+	s.orderUpdates.notify()
+	s.inventory.notify()
+
+	return &supplychain.ReceiveReturnResponse{Return: ret}, nil
+}
+
+func (s *SupplyChainServer) GetReturn(ctx context.Context, req *supplychain.GetReturnRequest) (*supplychain.GetReturnResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "Return ID required")
+	}
+
+	ret, err := loadReturn(ctx, s.db, req.Id)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "Return not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch return")
+	}
+	if err := checkOrderOwner(ctx, s.db, ret.OrderId); err != nil {
+		return nil, err
+	}
+
+	return &supplychain.GetReturnResponse{Return: ret}, nil
+}
+
+// loadReturn reads a return and its lines, it returns sql.ErrNoRows for unknown ids
+func loadReturn(ctx context.Context, q queryer, id string) (*supplychain.Return, error) {
+	var ret supplychain.Return
+	var reason sql.NullString
+	var refundValue int64
+	var currency string
+	err := q.QueryRowContext(ctx,
+		"SELECT id, order_id, status, reason, refund_value, refund_currency, created_at, updated_at FROM returns WHERE id = ?",
+		id).Scan(&ret.Id, &ret.OrderId, &ret.Status, &reason, &refundValue, &currency, &ret.CreatedAt, &ret.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	ret.Reason = reason.String
+	ret.RefundTotal = formatAmount(ctx, &supplychain.Amount{Value: refundValue, Currency: currency})
+
+	rows, err := q.QueryContext(ctx,
+		"SELECT item_id, quantity, reason_code, COALESCE(condition, ''), COALESCE(disposition, ''), COALESCE(location, ''), refund_value FROM return_items WHERE return_id = ?",
+		id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item supplychain.ReturnItem
+		var refund int64
+		if err := rows.Scan(&item.ItemId, &item.Quantity, &item.ReasonCode, &item.Condition, &item.Disposition, &item.Location, &refund); err != nil {
+			return nil, err
+		}
+		item.Refund = formatAmount(ctx, &supplychain.Amount{Value: refund, Currency: currency})
+		ret.Items = append(ret.Items, &item)
+	}
+	return &ret, rows.Err()
+}
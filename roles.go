@@ -0,0 +1,242 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Scrimzay/supplychain/db"
+	"github.com/Scrimzay/supplychain/policy"
+	"github.com/Scrimzay/supplychain/supplychain"
+)
+
+// methodPermissions is the permission every RPC needs, RPCs missing here
+// can't be called by anyone
+var methodPermissions = map[string]string{
+	"/supplychain.SupplyChain/CreateItem": "items:create",
+	"/supplychain.SupplyChain/UpdateItem": "items:update",
+	"/supplychain.SupplyChain/DeleteItem": "items:delete",
+	"/supplychain.SupplyChain/ListItems":  "items:read",
+
+	"/supplychain.SupplyChain/WatchInventory":   "items:read",
+	"/supplychain.SupplyChain/ImportItems":      "items:import",
+	"/supplychain.SupplyChain/BatchUpdateItems": "items:update",
+
+	"/supplychain.SupplyChain/CreateOrder":    "orders:create",
+	"/supplychain.SupplyChain/GetOrder":       "orders:read",
+	"/supplychain.SupplyChain/ListOrders":     "orders:read",
+	"/supplychain.SupplyChain/CancelOrder":    "orders:cancel",
+	"/supplychain.SupplyChain/FulfillOrder":   "orders:fulfill",
+	"/supplychain.SupplyChain/WatchOrder":     "orders:read",
+	"/supplychain.SupplyChain/PickSession":    "orders:fulfill",
+	"/supplychain.SupplyChain/BatchGetOrders": "orders:read",
+
+	"/supplychain.SupplyChain/CreateShipment":       "shipments:create",
+	"/supplychain.SupplyChain/UpdateShipment":       "shipments:update",
+	"/supplychain.SupplyChain/GetShipment":          "shipments:read",
+	"/supplychain.SupplyChain/ListShipments":        "shipments:read",
+	"/supplychain.SupplyChain/WatchShipment":        "shipments:read",
+	"/supplychain.SupplyChain/BatchUpdateShipments": "shipments:update",
+
+	"/supplychain.SupplyChain/CreateReturn":  "returns:create",
+	"/supplychain.SupplyChain/ApproveReturn": "returns:approve",
+	"/supplychain.SupplyChain/ReceiveReturn": "returns:receive",
+	"/supplychain.SupplyChain/GetReturn":     "returns:read",
+
+	"/supplychain.SupplyChain/AddExchangeRates":    "exchange_rates:write",
+	"/supplychain.SupplyChain/ImportExchangeRates": "exchange_rates:write",
+	"/supplychain.SupplyChain/ListExchangeRates":   "exchange_rates:read",
+
+	"/supplychain.SupplyChain/CreatePromotion":     "promotions:write",
+	"/supplychain.SupplyChain/DeactivatePromotion": "promotions:write",
+	"/supplychain.SupplyChain/ListPromotions":      "promotions:read",
+
+	"/supplychain.SupplyChain/CreateCustomer":        "customers:write",
+	"/supplychain.SupplyChain/UpdateCustomer":        "customers:write",
+	"/supplychain.SupplyChain/DeleteCustomer":        "customers:write",
+	"/supplychain.SupplyChain/AddCustomerAddress":    "customers:write",
+	"/supplychain.SupplyChain/RemoveCustomerAddress": "customers:write",
+	"/supplychain.SupplyChain/GetCustomer":           "customers:read",
+	"/supplychain.SupplyChain/ListCustomers":         "customers:read",
+
+	"/supplychain.SupplyChain/AuditLogs": "audit:read",
+
+	"/supplychain.SupplyChain/CreateApiKey": "api_keys:manage",
+	"/supplychain.SupplyChain/ListApiKeys":  "api_keys:manage",
+	"/supplychain.SupplyChain/RevokeApiKey": "api_keys:manage",
+	"/supplychain.SupplyChain/RotateApiKey": "api_keys:manage",
+
+	"/supplychain.SupplyChain/ListRoles":       "roles:read",
+	"/supplychain.SupplyChain/ListPermissions": "roles:read",
+	"/supplychain.SupplyChain/PutRole":         "roles:manage",
+	"/supplychain.SupplyChain/DeleteRole":      "roles:manage",
+}
+
+// defaultRoles are the roles a new database starts with
+var defaultRoles = []*policy.Role{
+	{Name: "admin", Description: "Everything", Permissions: []string{"*"}},
+	{Name: "customer", Description: "Places and follows its own orders", Permissions: []string{
+		"items:read", "orders:create", "orders:read", "orders:cancel", "shipments:read",
+		"returns:create", "returns:read", "exchange_rates:read", "customers:read",
+	}},
+}
+
+// builtinRoles are relied on by the server itself and can't be deleted,
+// the ones set to true can't be changed either
+var builtinRoles = map[string]bool{"admin": true, "customer": false}
+
+// knownPermission reports whether a permission grants anything, "*" and
+// resource:* for a known resource count
+func knownPermission(permission string) bool {
+	if permission == "*" {
+		return true
+	}
+	resource, action, _ := strings.Cut(permission, ":")
+	for _, known := range methodPermissions {
+		knownResource, knownAction, _ := strings.Cut(known, ":")
+		if resource == knownResource && (action == "*" || action == knownAction) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadPolicy builds the policy from the roles in the database
+func loadPolicy(store *db.DatabaseStruct) (*policy.Policy, error) {
+	roles, err := store.ListRoles()
+	if err != nil {
+		return nil, err
+	}
+	return policy.New(roles)
+}
+
+// watchPolicyFile puts a policy file back in force whenever it changes. A
+// file that doesn't load leaves the last good policy in place.
+func watchPolicyFile(path string, holder *policy.Holder, interval time.Duration) {
+	var lastMod time.Time
+	if info, err := os.Stat(path); err == nil {
+		lastMod = info.ModTime()
+	}
+	for range time.Tick(interval) {
+		info, err := os.Stat(path)
+		if err != nil || info.ModTime().Equal(lastMod) {
+			continue
+		}
+		lastMod = info.ModTime()
+		p, err := policy.LoadFile(path)
+		if err != nil {
+			log.Printf("Failed to reload policy file, keeping the current policy: %v", err)
+			continue
+		}
+		holder.Store(p)
+		log.Printf("Reloaded policy file %s", path)
+	}
+}
+
+// roleProto converts a role for a response
+func roleProto(role *policy.Role) *supplychain.Role {
+	return &supplychain.Role{Name: role.Name, Description: role.Description, Permissions: role.Permissions}
+}
+
+func (s *SupplyChainServer) ListRoles(ctx context.Context, req *supplychain.ListRolesRequest) (*supplychain.ListRolesResponse, error) {
+	resp := &supplychain.ListRolesResponse{FromFile: s.policyFile != ""}
+	for _, role := range s.policy.Load().Roles() {
+		resp.Roles = append(resp.Roles, roleProto(role))
+	}
+	return resp, nil
+}
+
+func (s *SupplyChainServer) PutRole(ctx context.Context, req *supplychain.PutRoleRequest) (*supplychain.PutRoleResponse, error) {
+	if s.policyFile != "" {
+		return nil, status.Error(codes.FailedPrecondition, "Roles come from the policy file, edit it instead")
+	}
+	if req.Role == nil || !policy.ValidRoleName(req.Role.Name) {
+		return nil, status.Error(codes.InvalidArgument, "Invalid role name")
+	}
+	if builtinRoles[req.Role.Name] {
+		return nil, status.Errorf(codes.FailedPrecondition, "The %s role can't be changed", req.Role.Name)
+	}
+	role := &policy.Role{Name: req.Role.Name, Description: req.Role.Description}
+	seen := map[string]bool{}
+	for _, permission := range req.Role.Permissions {
+		permission = strings.TrimSpace(permission)
+		if !policy.ValidPermission(permission) || !knownPermission(permission) {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown permission %q", permission)
+		}
+		if !seen[permission] {
+			role.Permissions = append(role.Permissions, permission)
+			seen[permission] = true
+		}
+	}
+	sort.Strings(role.Permissions)
+
+	if err := s.db.PutRole(role); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to store role")
+	}
+	if err := s.reloadPolicy(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to apply policy")
+	}
+
+	return &supplychain.PutRoleResponse{Role: roleProto(role)}, nil
+}
+
+func (s *SupplyChainServer) DeleteRole(ctx context.Context, req *supplychain.DeleteRoleRequest) (*supplychain.DeleteRoleResponse, error) {
+	if s.policyFile != "" {
+		return nil, status.Error(codes.FailedPrecondition, "Roles come from the policy file, edit it instead")
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "Role name required")
+	}
+	if _, ok := builtinRoles[req.Name]; ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "The %s role can't be deleted", req.Name)
+	}
+
+	err := s.db.DeleteRole(req.Name)
+	if errors.Is(err, db.ErrRoleNotFound) {
+		return nil, status.Error(codes.NotFound, "Role not found")
+	}
+	if errors.Is(err, db.ErrRoleInUse) {
+		return nil, status.Error(codes.FailedPrecondition, "Role is still given to API keys, revoke them first")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to delete role")
+	}
+	if err := s.reloadPolicy(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to apply policy")
+	}
+
+	return &supplychain.DeleteRoleResponse{Success: true}, nil
+}
+
+func (s *SupplyChainServer) ListPermissions(ctx context.Context, req *supplychain.ListPermissionsRequest) (*supplychain.ListPermissionsResponse, error) {
+	methods := map[string][]string{}
+	for method, permission := range methodPermissions {
+		methods[permission] = append(methods[permission], strings.TrimPrefix(method, "/supplychain.SupplyChain/"))
+	}
+
+	resp := &supplychain.ListPermissionsResponse{}
+	for permission, names := range methods {
+		sort.Strings(names)
+		resp.Permissions = append(resp.Permissions, &supplychain.Permission{Name: permission, Methods: names})
+	}
+	sort.Slice(resp.Permissions, func(i, j int) bool { return resp.Permissions[i].Name < resp.Permissions[j].Name })
+
+	return resp, nil
+}
+
+// reloadPolicy puts the roles in the database in force
+func (s *SupplyChainServer) reloadPolicy() error {
+	p, err := loadPolicy(s.db)
+	if err != nil {
+		return err
+	}
+	s.policy.Store(p)
+	return nil
+}
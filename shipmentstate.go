@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Scrimzay/supplychain/supplychain"
+)
+
+// Shipment statuses
+const (
+	shipmentPending        = "PENDING"
+	shipmentLabelCreated   = "LABEL_CREATED"
+	shipmentPickedUp       = "PICKED_UP"
+	shipmentInTransit      = "IN_TRANSIT"
+	shipmentOutForDelivery = "OUT_FOR_DELIVERY"
+	shipmentDelivered      = "DELIVERED"
+	shipmentException      = "EXCEPTION"
+	shipmentReturned       = "RETURNED"
+)
+
+// shipmentTransitions lists the statuses a shipment may move to from each status,
+// in-transit scans may repeat to report a new location
+var shipmentTransitions = map[string][]string{
+	shipmentPending:        {shipmentLabelCreated, shipmentPickedUp, shipmentException},
+	shipmentLabelCreated:   {shipmentPickedUp, shipmentException},
+	shipmentPickedUp:       {shipmentInTransit, shipmentException},
+	shipmentInTransit:      {shipmentInTransit, shipmentOutForDelivery, shipmentDelivered, shipmentException},
+	shipmentOutForDelivery: {shipmentInTransit, shipmentDelivered, shipmentException},
+	shipmentException:      {shipmentInTransit, shipmentOutForDelivery, shipmentDelivered, shipmentReturned},
+}
+
+// isShipmentStatus reports whether s is a known shipment status
+func isShipmentStatus(s string) bool {
+	switch s {
+	case shipmentPending, shipmentLabelCreated, shipmentPickedUp, shipmentInTransit,
+		shipmentOutForDelivery, shipmentDelivered, shipmentException, shipmentReturned:
+		return true
+	}
+	return false
+}
+
+// canTransitionShipment reports whether a shipment in status from may move to status to
+func canTransitionShipment(from, to string) bool {
+	for _, next := range shipmentTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// recordShipmentEvent appends an entry to the shipment's tracking history
+func recordShipmentEvent(ctx context.Context, tx *sql.Tx, shipmentID, from, to, location, note string, timestamp int64) error {
+	_, err := tx.ExecContext(ctx,
+		"INSERT INTO shipment_events (shipment_id, from_status, to_status, location, note, timestamp) VALUES (?, ?, ?, ?, ?, ?)",
+		shipmentID, from, to, location, note, timestamp)
+	return err
+}
+
+// deliverOrderIfComplete moves a shipped order to delivered once none of its
+// shipments are still on the way
+func deliverOrderIfComplete(ctx context.Context, tx *sql.Tx, orderID string) error {
+	var orderStatus string
+	var undelivered int
+	err := tx.QueryRowContext(ctx,
+		"SELECT status, (SELECT COUNT(*) FROM shipments WHERE order_id = orders.id AND status != ?) FROM orders WHERE id = ?",
+		shipmentDelivered, orderID).Scan(&orderStatus, &undelivered)
+	if err != nil {
+		return status.Error(codes.Internal, "Failed to check order")
+	}
+	if orderStatus != orderShipped || undelivered > 0 {
+		return nil
+	}
+	return transitionOrder(ctx, tx, orderID, orderShipped, orderDelivered, "All shipments delivered")
+}
+
+// updateShipmentStatus validates and applies a status change to a shipment,
+// recording it in the tracking history
+func updateShipmentStatus(ctx context.Context, tx *sql.Tx, req *supplychain.UpdateShipmentRequest) error {
+	if !isShipmentStatus(req.Status) {
+		return status.Errorf(codes.InvalidArgument, "Unknown shipment status %s", req.Status)
+	}
+
+	var current, orderID string
+	err := tx.QueryRowContext(ctx, "SELECT status, order_id FROM shipments WHERE id = ?", req.Id).Scan(&current, &orderID)
+	if err == sql.ErrNoRows {
+		return status.Error(codes.NotFound, "Shipment not found")
+	}
+	if err != nil {
+		return status.Error(codes.Internal, "Failed to check shipment")
+	}
+	if !canTransitionShipment(current, req.Status) {
+		return status.Errorf(codes.FailedPrecondition, "Cannot move shipment from %s to %s", current, req.Status)
+	}
+
+	now := time.Now().Unix()
+	_, err = tx.ExecContext(ctx,
+		"UPDATE shipments SET status = ?, tracking_number = COALESCE(NULLIF(?, ''), tracking_number), updated_at = ? WHERE id = ?",
+		req.Status, req.TrackingNumber, now, req.Id)
+	if err != nil {
+		return status.Error(codes.Internal, "Failed to update shipment")
+	}
+	if err := recordShipmentEvent(ctx, tx, req.Id, current, req.Status, req.Location, req.Note, now); err != nil {
+		return status.Error(codes.Internal, "Failed to record shipment event")
+	}
+
+	if req.Status == shipmentDelivered {
+		return deliverOrderIfComplete(ctx, tx, orderID)
+	}
+	return nil
+}
+
+// loadShipment reads a shipment, it returns sql.ErrNoRows for unknown ids
+func loadShipment(ctx context.Context, q queryer, id string) (*supplychain.Shipment, error) {
+	var shipment supplychain.Shipment
+	var trackingNumber sql.NullString
+	err := q.QueryRowContext(ctx,
+		"SELECT id, order_id, status, tracking_number, updated_at FROM shipments WHERE id = ?",
+		id).Scan(&shipment.Id, &shipment.OrderId, &shipment.Status, &trackingNumber, &shipment.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	shipment.TrackingNumber = trackingNumber.String
+
+	shipment.Items, err = loadShipmentItems(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+	// the parcel goes wherever its order was placed to
+	shipment.ShipTo, err = loadShipTo(ctx, q, shipment.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	return &shipment, nil
+}
+
+// loadShipmentEvents returns the tracking history of a shipment after an
+// event id (0 for all of it), oldest first
+func loadShipmentEvents(ctx context.Context, q queryer, shipmentID string, afterID int64) ([]*supplychain.ShipmentEvent, error) {
+	rows, err := q.QueryContext(ctx,
+		"SELECT id, shipment_id, from_status, to_status, COALESCE(location, ''), COALESCE(note, ''), timestamp FROM shipment_events WHERE shipment_id = ? AND id > ? ORDER BY id",
+		shipmentID, afterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*supplychain.ShipmentEvent
+	for rows.Next() {
+		var event supplychain.ShipmentEvent
+		if err := rows.Scan(&event.Id, &event.ShipmentId, &event.FromStatus, &event.ToStatus, &event.Location, &event.Note, &event.Timestamp); err != nil {
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+	return events, rows.Err()
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.4
+// 	protoc-gen-go v1.36.6
 // 	protoc        v5.27.3
 // source: supplychain/supplychain.proto
 
@@ -21,6 +21,359 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AddressType int32
+
+const (
+	AddressType_ADDRESS_TYPE_SHIPPING AddressType = 0
+	AddressType_ADDRESS_TYPE_BILLING  AddressType = 1
+)
+
+// Enum value maps for AddressType.
+var (
+	AddressType_name = map[int32]string{
+		0: "ADDRESS_TYPE_SHIPPING",
+		1: "ADDRESS_TYPE_BILLING",
+	}
+	AddressType_value = map[string]int32{
+		"ADDRESS_TYPE_SHIPPING": 0,
+		"ADDRESS_TYPE_BILLING":  1,
+	}
+)
+
+func (x AddressType) Enum() *AddressType {
+	p := new(AddressType)
+	*p = x
+	return p
+}
+
+func (x AddressType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AddressType) Descriptor() protoreflect.EnumDescriptor {
+	return file_supplychain_supplychain_proto_enumTypes[0].Descriptor()
+}
+
+func (AddressType) Type() protoreflect.EnumType {
+	return &file_supplychain_supplychain_proto_enumTypes[0]
+}
+
+func (x AddressType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AddressType.Descriptor instead.
+func (AddressType) EnumDescriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{0}
+}
+
+type PromotionType int32
+
+const (
+	PromotionType_PROMOTION_TYPE_PERCENTAGE    PromotionType = 0 // percent_off every eligible line
+	PromotionType_PROMOTION_TYPE_FIXED_AMOUNT  PromotionType = 1 // amount_off the eligible lines, split by their value
+	PromotionType_PROMOTION_TYPE_BUY_X_GET_Y   PromotionType = 2 // Every buy_quantity units of a line get the next get_quantity free
+	PromotionType_PROMOTION_TYPE_TIERED_VOLUME PromotionType = 3 // percent_off of the highest tier a line's quantity reaches
+)
+
+// Enum value maps for PromotionType.
+var (
+	PromotionType_name = map[int32]string{
+		0: "PROMOTION_TYPE_PERCENTAGE",
+		1: "PROMOTION_TYPE_FIXED_AMOUNT",
+		2: "PROMOTION_TYPE_BUY_X_GET_Y",
+		3: "PROMOTION_TYPE_TIERED_VOLUME",
+	}
+	PromotionType_value = map[string]int32{
+		"PROMOTION_TYPE_PERCENTAGE":    0,
+		"PROMOTION_TYPE_FIXED_AMOUNT":  1,
+		"PROMOTION_TYPE_BUY_X_GET_Y":   2,
+		"PROMOTION_TYPE_TIERED_VOLUME": 3,
+	}
+)
+
+func (x PromotionType) Enum() *PromotionType {
+	p := new(PromotionType)
+	*p = x
+	return p
+}
+
+func (x PromotionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PromotionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_supplychain_supplychain_proto_enumTypes[1].Descriptor()
+}
+
+func (PromotionType) Type() protoreflect.EnumType {
+	return &file_supplychain_supplychain_proto_enumTypes[1]
+}
+
+func (x PromotionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PromotionType.Descriptor instead.
+func (PromotionType) EnumDescriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{1}
+}
+
+// How FulfillOrder treats lines that stock can't cover
+type FulfillmentMode int32
+
+const (
+	FulfillmentMode_FULFILLMENT_MODE_ALL_OR_NOTHING FulfillmentMode = 0
+	FulfillmentMode_FULFILLMENT_MODE_PARTIAL        FulfillmentMode = 1 // Fulfill what is on hand, backorder the rest
+)
+
+// Enum value maps for FulfillmentMode.
+var (
+	FulfillmentMode_name = map[int32]string{
+		0: "FULFILLMENT_MODE_ALL_OR_NOTHING",
+		1: "FULFILLMENT_MODE_PARTIAL",
+	}
+	FulfillmentMode_value = map[string]int32{
+		"FULFILLMENT_MODE_ALL_OR_NOTHING": 0,
+		"FULFILLMENT_MODE_PARTIAL":        1,
+	}
+)
+
+func (x FulfillmentMode) Enum() *FulfillmentMode {
+	p := new(FulfillmentMode)
+	*p = x
+	return p
+}
+
+func (x FulfillmentMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FulfillmentMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_supplychain_supplychain_proto_enumTypes[2].Descriptor()
+}
+
+func (FulfillmentMode) Type() protoreflect.EnumType {
+	return &file_supplychain_supplychain_proto_enumTypes[2]
+}
+
+func (x FulfillmentMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FulfillmentMode.Descriptor instead.
+func (FulfillmentMode) EnumDescriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{2}
+}
+
+// What a scan in a pick session came to
+type PickStatus int32
+
+const (
+	PickStatus_PICK_STATUS_STARTED    PickStatus = 0 // The order is ready to pick, lines lists what to pick
+	PickStatus_PICK_STATUS_PICKED     PickStatus = 1 // The scan counted towards its line
+	PickStatus_PICK_STATUS_WRONG_ITEM PickStatus = 2 // The item isn't on the order, or its line is already fulfilled
+	PickStatus_PICK_STATUS_OVER_PICK  PickStatus = 3 // More than the line needs, the scan didn't count
+	PickStatus_PICK_STATUS_COMPLETED  PickStatus = 4 // Every line is picked and the order was fulfilled
+	PickStatus_PICK_STATUS_FAILED     PickStatus = 5 // Every line is picked but fulfillment failed, the pick is dropped
+	PickStatus_PICK_STATUS_REJECTED   PickStatus = 6 // The scan couldn't be used, see message
+)
+
+// Enum value maps for PickStatus.
+var (
+	PickStatus_name = map[int32]string{
+		0: "PICK_STATUS_STARTED",
+		1: "PICK_STATUS_PICKED",
+		2: "PICK_STATUS_WRONG_ITEM",
+		3: "PICK_STATUS_OVER_PICK",
+		4: "PICK_STATUS_COMPLETED",
+		5: "PICK_STATUS_FAILED",
+		6: "PICK_STATUS_REJECTED",
+	}
+	PickStatus_value = map[string]int32{
+		"PICK_STATUS_STARTED":    0,
+		"PICK_STATUS_PICKED":     1,
+		"PICK_STATUS_WRONG_ITEM": 2,
+		"PICK_STATUS_OVER_PICK":  3,
+		"PICK_STATUS_COMPLETED":  4,
+		"PICK_STATUS_FAILED":     5,
+		"PICK_STATUS_REJECTED":   6,
+	}
+)
+
+func (x PickStatus) Enum() *PickStatus {
+	p := new(PickStatus)
+	*p = x
+	return p
+}
+
+func (x PickStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PickStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_supplychain_supplychain_proto_enumTypes[3].Descriptor()
+}
+
+func (PickStatus) Type() protoreflect.EnumType {
+	return &file_supplychain_supplychain_proto_enumTypes[3]
+}
+
+func (x PickStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PickStatus.Descriptor instead.
+func (PickStatus) EnumDescriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{3}
+}
+
+// Field ListOrders sorts by, ties are broken by order id
+type OrderSortField int32
+
+const (
+	OrderSortField_ORDER_SORT_CREATED_AT OrderSortField = 0
+	OrderSortField_ORDER_SORT_TOTAL      OrderSortField = 1
+)
+
+// Enum value maps for OrderSortField.
+var (
+	OrderSortField_name = map[int32]string{
+		0: "ORDER_SORT_CREATED_AT",
+		1: "ORDER_SORT_TOTAL",
+	}
+	OrderSortField_value = map[string]int32{
+		"ORDER_SORT_CREATED_AT": 0,
+		"ORDER_SORT_TOTAL":      1,
+	}
+)
+
+func (x OrderSortField) Enum() *OrderSortField {
+	p := new(OrderSortField)
+	*p = x
+	return p
+}
+
+func (x OrderSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_supplychain_supplychain_proto_enumTypes[4].Descriptor()
+}
+
+func (OrderSortField) Type() protoreflect.EnumType {
+	return &file_supplychain_supplychain_proto_enumTypes[4]
+}
+
+func (x OrderSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderSortField.Descriptor instead.
+func (OrderSortField) EnumDescriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{4}
+}
+
+type InventoryEventType int32
+
+const (
+	InventoryEventType_INVENTORY_EVENT_ITEM_CREATED     InventoryEventType = 0
+	InventoryEventType_INVENTORY_EVENT_ITEM_UPDATED     InventoryEventType = 1
+	InventoryEventType_INVENTORY_EVENT_ITEM_DELETED     InventoryEventType = 2
+	InventoryEventType_INVENTORY_EVENT_QUANTITY_CHANGED InventoryEventType = 3 // Stock moved by fulfillment or returns
+)
+
+// Enum value maps for InventoryEventType.
+var (
+	InventoryEventType_name = map[int32]string{
+		0: "INVENTORY_EVENT_ITEM_CREATED",
+		1: "INVENTORY_EVENT_ITEM_UPDATED",
+		2: "INVENTORY_EVENT_ITEM_DELETED",
+		3: "INVENTORY_EVENT_QUANTITY_CHANGED",
+	}
+	InventoryEventType_value = map[string]int32{
+		"INVENTORY_EVENT_ITEM_CREATED":     0,
+		"INVENTORY_EVENT_ITEM_UPDATED":     1,
+		"INVENTORY_EVENT_ITEM_DELETED":     2,
+		"INVENTORY_EVENT_QUANTITY_CHANGED": 3,
+	}
+)
+
+func (x InventoryEventType) Enum() *InventoryEventType {
+	p := new(InventoryEventType)
+	*p = x
+	return p
+}
+
+func (x InventoryEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InventoryEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_supplychain_supplychain_proto_enumTypes[5].Descriptor()
+}
+
+func (InventoryEventType) Type() protoreflect.EnumType {
+	return &file_supplychain_supplychain_proto_enumTypes[5]
+}
+
+func (x InventoryEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InventoryEventType.Descriptor instead.
+func (InventoryEventType) EnumDescriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{5}
+}
+
+// How a batch treats entries that fail
+type BatchMode int32
+
+const (
+	BatchMode_BATCH_MODE_ALL_OR_NOTHING BatchMode = 0 // One failed entry leaves everything as it was
+	BatchMode_BATCH_MODE_BEST_EFFORT    BatchMode = 1 // The entries that work are kept
+)
+
+// Enum value maps for BatchMode.
+var (
+	BatchMode_name = map[int32]string{
+		0: "BATCH_MODE_ALL_OR_NOTHING",
+		1: "BATCH_MODE_BEST_EFFORT",
+	}
+	BatchMode_value = map[string]int32{
+		"BATCH_MODE_ALL_OR_NOTHING": 0,
+		"BATCH_MODE_BEST_EFFORT":    1,
+	}
+)
+
+func (x BatchMode) Enum() *BatchMode {
+	p := new(BatchMode)
+	*p = x
+	return p
+}
+
+func (x BatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_supplychain_supplychain_proto_enumTypes[6].Descriptor()
+}
+
+func (BatchMode) Type() protoreflect.EnumType {
+	return &file_supplychain_supplychain_proto_enumTypes[6]
+}
+
+func (x BatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchMode.Descriptor instead.
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{6}
+}
+
 // Represents a monetary amount
 type Amount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -84,15 +437,18 @@ func (x *Amount) GetDisplayValue() string {
 
 // Inventory item
 type Item struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	UnitPrice     *Amount                `protobuf:"bytes,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity          int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"` // On hand
+	UnitPrice         *Amount                `protobuf:"bytes,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	UpdatedAt         int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ReservedQuantity  int32                  `protobuf:"varint,7,opt,name=reserved_quantity,json=reservedQuantity,proto3" json:"reserved_quantity,omitempty"`    // Held by open orders
+	AvailableQuantity int32                  `protobuf:"varint,8,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"` // On hand minus reserved
+	TaxCategory       string                 `protobuf:"bytes,9,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`                    // STANDARD when empty
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Item) Reset() {
@@ -167,17 +523,46 @@ func (x *Item) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *Item) GetReservedQuantity() int32 {
+	if x != nil {
+		return x.ReservedQuantity
+	}
+	return 0
+}
+
+func (x *Item) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *Item) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
 // Order details
 type Order struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Items         []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
-	Total         *Amount                `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId       string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Items            []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Total            *Amount                `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"` // Grand total, subtotal plus tax
+	Status           string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt        int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Subtotal         *Amount                `protobuf:"bytes,7,opt,name=subtotal,proto3" json:"subtotal,omitempty"` // Sum of the lines without tax
+	TaxTotal         *Amount                `protobuf:"bytes,8,opt,name=tax_total,json=taxTotal,proto3" json:"tax_total,omitempty"`
+	ShipToRegion     string                 `protobuf:"bytes,9,opt,name=ship_to_region,json=shipToRegion,proto3" json:"ship_to_region,omitempty"`
+	TaxJurisdiction  string                 `protobuf:"bytes,10,opt,name=tax_jurisdiction,json=taxJurisdiction,proto3" json:"tax_jurisdiction,omitempty"`       // Rules the tax was worked out under
+	PricesIncludeTax bool                   `protobuf:"varint,11,opt,name=prices_include_tax,json=pricesIncludeTax,proto3" json:"prices_include_tax,omitempty"` // Line totals already contain their tax
+	DiscountTotal    *Amount                `protobuf:"bytes,12,opt,name=discount_total,json=discountTotal,proto3" json:"discount_total,omitempty"`
+	CouponCodes      []string               `protobuf:"bytes,13,rep,name=coupon_codes,json=couponCodes,proto3" json:"coupon_codes,omitempty"` // Coupons that took something off
+	ShipTo           *Address               `protobuf:"bytes,14,opt,name=ship_to,json=shipTo,proto3" json:"ship_to,omitempty"`                // Copy of the customer's address when the order was placed
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Order) Reset() {
@@ -252,29 +637,92 @@ func (x *Order) GetCreatedAt() int64 {
 	return 0
 }
 
-// Item in an Order
-type OrderItem struct {
+func (x *Order) GetSubtotal() *Amount {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *Order) GetTaxTotal() *Amount {
+	if x != nil {
+		return x.TaxTotal
+	}
+	return nil
+}
+
+func (x *Order) GetShipToRegion() string {
+	if x != nil {
+		return x.ShipToRegion
+	}
+	return ""
+}
+
+func (x *Order) GetTaxJurisdiction() string {
+	if x != nil {
+		return x.TaxJurisdiction
+	}
+	return ""
+}
+
+func (x *Order) GetPricesIncludeTax() bool {
+	if x != nil {
+		return x.PricesIncludeTax
+	}
+	return false
+}
+
+func (x *Order) GetDiscountTotal() *Amount {
+	if x != nil {
+		return x.DiscountTotal
+	}
+	return nil
+}
+
+func (x *Order) GetCouponCodes() []string {
+	if x != nil {
+		return x.CouponCodes
+	}
+	return nil
+}
+
+func (x *Order) GetShipTo() *Address {
+	if x != nil {
+		return x.ShipTo
+	}
+	return nil
+}
+
+// Postal address
+type Address struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          AddressType            `protobuf:"varint,2,opt,name=type,proto3,enum=supplychain.AddressType" json:"type,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // Recipient
+	Line1         string                 `protobuf:"bytes,4,opt,name=line1,proto3" json:"line1,omitempty"`
+	Line2         string                 `protobuf:"bytes,5,opt,name=line2,proto3" json:"line2,omitempty"`
+	City          string                 `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`
+	Region        string                 `protobuf:"bytes,7,opt,name=region,proto3" json:"region,omitempty"` // State or province, e.g. "CA"
+	PostalCode    string                 `protobuf:"bytes,8,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Country       string                 `protobuf:"bytes,9,opt,name=country,proto3" json:"country,omitempty"` // ISO 3166 alpha-2, e.g. "US"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderItem) Reset() {
-	*x = OrderItem{}
+func (x *Address) Reset() {
+	*x = Address{}
 	mi := &file_supplychain_supplychain_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderItem) String() string {
+func (x *Address) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderItem) ProtoMessage() {}
+func (*Address) ProtoMessage() {}
 
-func (x *OrderItem) ProtoReflect() protoreflect.Message {
+func (x *Address) ProtoReflect() protoreflect.Message {
 	mi := &file_supplychain_supplychain_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -286,51 +734,102 @@ func (x *OrderItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
-func (*OrderItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
 	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *OrderItem) GetItemId() string {
+func (x *Address) GetId() string {
 	if x != nil {
-		return x.ItemId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *OrderItem) GetQuantity() int32 {
+func (x *Address) GetType() AddressType {
 	if x != nil {
-		return x.Quantity
+		return x.Type
 	}
-	return 0
+	return AddressType_ADDRESS_TYPE_SHIPPING
 }
 
-// Shipment details
-type Shipment struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrderId        string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	TrackingNumber string                 `protobuf:"bytes,4,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
-	UpdatedAt      int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *Address) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *Shipment) Reset() {
-	*x = Shipment{}
+func (x *Address) GetLine1() string {
+	if x != nil {
+		return x.Line1
+	}
+	return ""
+}
+
+func (x *Address) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Address) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+// Customer who places orders
+type Customer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	Addresses     []*Address             `protobuf:"bytes,5,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Customer) Reset() {
+	*x = Customer{}
 	mi := &file_supplychain_supplychain_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Shipment) String() string {
+func (x *Customer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Shipment) ProtoMessage() {}
+func (*Customer) ProtoMessage() {}
 
-func (x *Shipment) ProtoReflect() protoreflect.Message {
+func (x *Customer) ProtoReflect() protoreflect.Message {
 	mi := &file_supplychain_supplychain_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -342,71 +841,101 @@ func (x *Shipment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Shipment.ProtoReflect.Descriptor instead.
-func (*Shipment) Descriptor() ([]byte, []int) {
+// Deprecated: Use Customer.ProtoReflect.Descriptor instead.
+func (*Customer) Descriptor() ([]byte, []int) {
 	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Shipment) GetId() string {
+func (x *Customer) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Shipment) GetOrderId() string {
+func (x *Customer) GetName() string {
 	if x != nil {
-		return x.OrderId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Shipment) GetStatus() string {
+func (x *Customer) GetEmail() string {
 	if x != nil {
-		return x.Status
+		return x.Email
 	}
 	return ""
 }
 
-func (x *Shipment) GetTrackingNumber() string {
+func (x *Customer) GetPhone() string {
 	if x != nil {
-		return x.TrackingNumber
+		return x.Phone
 	}
 	return ""
 }
 
-func (x *Shipment) GetUpdatedAt() int64 {
+func (x *Customer) GetAddresses() []*Address {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *Customer) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Customer) GetUpdatedAt() int64 {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return 0
 }
 
-// requests and Responses
-type CreateItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	UnitPrice     *Amount                `protobuf:"bytes,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Item in an Order
+type OrderItem struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ItemId              string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Quantity            int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	FulfilledQuantity   int32                  `protobuf:"varint,3,opt,name=fulfilled_quantity,json=fulfilledQuantity,proto3" json:"fulfilled_quantity,omitempty"`
+	ShippedQuantity     int32                  `protobuf:"varint,4,opt,name=shipped_quantity,json=shippedQuantity,proto3" json:"shipped_quantity,omitempty"`
+	OutstandingQuantity int32                  `protobuf:"varint,5,opt,name=outstanding_quantity,json=outstandingQuantity,proto3" json:"outstanding_quantity,omitempty"` // Ordered but not yet shipped
+	// Snapshot of the item when the order was placed
+	UnitPrice   *Amount `protobuf:"bytes,6,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	Name        string  `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	Description string  `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	LineTotal   *Amount `protobuf:"bytes,9,opt,name=line_total,json=lineTotal,proto3" json:"line_total,omitempty"`
+	// Set when the line was converted into the order's settlement currency
+	OriginalUnitPrice *Amount         `protobuf:"bytes,10,opt,name=original_unit_price,json=originalUnitPrice,proto3" json:"original_unit_price,omitempty"`
+	OriginalLineTotal *Amount         `protobuf:"bytes,11,opt,name=original_line_total,json=originalLineTotal,proto3" json:"original_line_total,omitempty"`
+	ExchangeRate      string          `protobuf:"bytes,12,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"` // Major units of settlement currency per major unit of the original
+	RateEffectiveAt   int64           `protobuf:"varint,13,opt,name=rate_effective_at,json=rateEffectiveAt,proto3" json:"rate_effective_at,omitempty"`
+	Tax               *Amount         `protobuf:"bytes,14,opt,name=tax,proto3" json:"tax,omitempty"`
+	TaxRate           string          `protobuf:"bytes,15,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"` // Decimal, e.g. "0.0725"
+	TaxCategory       string          `protobuf:"bytes,16,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`
+	Discounts         []*LineDiscount `protobuf:"bytes,17,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	Discount          *Amount         `protobuf:"bytes,18,opt,name=discount,proto3" json:"discount,omitempty"` // Taken off line_total before tax
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *CreateItemRequest) Reset() {
-	*x = CreateItemRequest{}
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
 	mi := &file_supplychain_supplychain_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateItemRequest) String() string {
+func (x *OrderItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateItemRequest) ProtoMessage() {}
+func (*OrderItem) ProtoMessage() {}
 
-func (x *CreateItemRequest) ProtoReflect() protoreflect.Message {
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
 	mi := &file_supplychain_supplychain_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -418,61 +947,5388 @@ func (x *CreateItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateItemRequest.ProtoReflect.Descriptor instead.
-func (*CreateItemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
 	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateItemRequest) GetName() string {
+func (x *OrderItem) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *OrderItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetFulfilledQuantity() int32 {
+	if x != nil {
+		return x.FulfilledQuantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetShippedQuantity() int32 {
+	if x != nil {
+		return x.ShippedQuantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetOutstandingQuantity() int32 {
+	if x != nil {
+		return x.OutstandingQuantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetUnitPrice() *Amount {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+func (x *OrderItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrderItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OrderItem) GetLineTotal() *Amount {
+	if x != nil {
+		return x.LineTotal
+	}
+	return nil
+}
+
+func (x *OrderItem) GetOriginalUnitPrice() *Amount {
+	if x != nil {
+		return x.OriginalUnitPrice
+	}
+	return nil
+}
+
+func (x *OrderItem) GetOriginalLineTotal() *Amount {
+	if x != nil {
+		return x.OriginalLineTotal
+	}
+	return nil
+}
+
+func (x *OrderItem) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+func (x *OrderItem) GetRateEffectiveAt() int64 {
+	if x != nil {
+		return x.RateEffectiveAt
+	}
+	return 0
+}
+
+func (x *OrderItem) GetTax() *Amount {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *OrderItem) GetTaxRate() string {
+	if x != nil {
+		return x.TaxRate
+	}
+	return ""
+}
+
+func (x *OrderItem) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
+func (x *OrderItem) GetDiscounts() []*LineDiscount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *OrderItem) GetDiscount() *Amount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+// Promotion's share of an order line
+type LineDiscount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   string                 `protobuf:"bytes,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Amount        *Amount                `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineDiscount) Reset() {
+	*x = LineDiscount{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineDiscount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineDiscount) ProtoMessage() {}
+
+func (x *LineDiscount) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineDiscount.ProtoReflect.Descriptor instead.
+func (*LineDiscount) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LineDiscount) GetPromotionId() string {
+	if x != nil {
+		return x.PromotionId
+	}
+	return ""
+}
+
+func (x *LineDiscount) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateItemRequest) GetDescription() string {
+func (x *LineDiscount) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *LineDiscount) GetAmount() *Amount {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type DiscountTier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinQuantity   int32                  `protobuf:"varint,1,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`
+	PercentOff    string                 `protobuf:"bytes,2,opt,name=percent_off,json=percentOff,proto3" json:"percent_off,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscountTier) Reset() {
+	*x = DiscountTier{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscountTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscountTier) ProtoMessage() {}
+
+func (x *DiscountTier) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscountTier.ProtoReflect.Descriptor instead.
+func (*DiscountTier) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DiscountTier) GetMinQuantity() int32 {
+	if x != nil {
+		return x.MinQuantity
+	}
+	return 0
+}
+
+func (x *DiscountTier) GetPercentOff() string {
+	if x != nil {
+		return x.PercentOff
+	}
+	return ""
+}
+
+// Discount that CreateOrder applies to matching orders
+type Promotion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          PromotionType          `protobuf:"varint,3,opt,name=type,proto3,enum=supplychain.PromotionType" json:"type,omitempty"`
+	Code          string                 `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`                               // Coupon code that has to be given, empty applies automatically
+	ItemIds       []string               `protobuf:"bytes,5,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`          // Lines it applies to, empty for every line
+	PercentOff    string                 `protobuf:"bytes,6,opt,name=percent_off,json=percentOff,proto3" json:"percent_off,omitempty"` // Decimal, e.g. "12.5"
+	AmountOff     *Amount                `protobuf:"bytes,7,opt,name=amount_off,json=amountOff,proto3" json:"amount_off,omitempty"`
+	BuyQuantity   int32                  `protobuf:"varint,8,opt,name=buy_quantity,json=buyQuantity,proto3" json:"buy_quantity,omitempty"`
+	GetQuantity   int32                  `protobuf:"varint,9,opt,name=get_quantity,json=getQuantity,proto3" json:"get_quantity,omitempty"`
+	Tiers         []*DiscountTier        `protobuf:"bytes,10,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	StartsAt      int64                  `protobuf:"varint,11,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`       // Unix time, 0 for no start
+	EndsAt        int64                  `protobuf:"varint,12,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`             // Unix time, exclusive, 0 for no end
+	UsageLimit    int32                  `protobuf:"varint,13,opt,name=usage_limit,json=usageLimit,proto3" json:"usage_limit,omitempty"` // Orders it can be used on, 0 for no limit
+	UsageCount    int32                  `protobuf:"varint,14,opt,name=usage_count,json=usageCount,proto3" json:"usage_count,omitempty"`
+	Active        bool                   `protobuf:"varint,15,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Promotion) Reset() {
+	*x = Promotion{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Promotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Promotion) ProtoMessage() {}
+
+func (x *Promotion) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Promotion.ProtoReflect.Descriptor instead.
+func (*Promotion) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Promotion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Promotion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Promotion) GetType() PromotionType {
+	if x != nil {
+		return x.Type
+	}
+	return PromotionType_PROMOTION_TYPE_PERCENTAGE
+}
+
+func (x *Promotion) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Promotion) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+func (x *Promotion) GetPercentOff() string {
+	if x != nil {
+		return x.PercentOff
+	}
+	return ""
+}
+
+func (x *Promotion) GetAmountOff() *Amount {
+	if x != nil {
+		return x.AmountOff
+	}
+	return nil
+}
+
+func (x *Promotion) GetBuyQuantity() int32 {
+	if x != nil {
+		return x.BuyQuantity
+	}
+	return 0
+}
+
+func (x *Promotion) GetGetQuantity() int32 {
+	if x != nil {
+		return x.GetQuantity
+	}
+	return 0
+}
+
+func (x *Promotion) GetTiers() []*DiscountTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+func (x *Promotion) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *Promotion) GetEndsAt() int64 {
+	if x != nil {
+		return x.EndsAt
+	}
+	return 0
+}
+
+func (x *Promotion) GetUsageLimit() int32 {
+	if x != nil {
+		return x.UsageLimit
+	}
+	return 0
+}
+
+func (x *Promotion) GetUsageCount() int32 {
+	if x != nil {
+		return x.UsageCount
+	}
+	return 0
+}
+
+func (x *Promotion) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Promotion) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// Price of one major unit of base_currency in quote_currency from effective_at on
+type ExchangeRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseCurrency  string                 `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	QuoteCurrency string                 `protobuf:"bytes,2,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
+	Rate          string                 `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`                                   // Decimal, e.g. "0.9215"
+	EffectiveAt   int64                  `protobuf:"varint,4,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"` // Unix time
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExchangeRate) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetQuoteCurrency() string {
+	if x != nil {
+		return x.QuoteCurrency
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetEffectiveAt() int64 {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return 0
+}
+
+// Entry in an order's history
+type OrderEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	FromStatus    string                 `protobuf:"bytes,4,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus      string                 `protobuf:"bytes,5,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderEvent) Reset() {
+	*x = OrderEvent{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderEvent) ProtoMessage() {}
+
+func (x *OrderEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderEvent.ProtoReflect.Descriptor instead.
+func (*OrderEvent) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *OrderEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrderEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// Shipment details
+type Shipment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId        string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	TrackingNumber string                 `protobuf:"bytes,4,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	UpdatedAt      int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Items          []*OrderItem           `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`                 // Order lines and quantities in this parcel
+	ShipTo         *Address               `protobuf:"bytes,7,opt,name=ship_to,json=shipTo,proto3" json:"ship_to,omitempty"` // Where the parcel is going, from the order
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Shipment) Reset() {
+	*x = Shipment{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shipment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shipment) ProtoMessage() {}
+
+func (x *Shipment) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shipment.ProtoReflect.Descriptor instead.
+func (*Shipment) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Shipment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Shipment) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Shipment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Shipment) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *Shipment) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Shipment) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Shipment) GetShipTo() *Address {
+	if x != nil {
+		return x.ShipTo
+	}
+	return nil
+}
+
+// Tracking event recorded on every shipment status change
+type ShipmentEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShipmentId    string                 `protobuf:"bytes,2,opt,name=shipment_id,json=shipmentId,proto3" json:"shipment_id,omitempty"`
+	FromStatus    string                 `protobuf:"bytes,3,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus      string                 `protobuf:"bytes,4,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Location      string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentEvent) Reset() {
+	*x = ShipmentEvent{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentEvent) ProtoMessage() {}
+
+func (x *ShipmentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentEvent.ProtoReflect.Descriptor instead.
+func (*ShipmentEvent) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ShipmentEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShipmentEvent) GetShipmentId() string {
+	if x != nil {
+		return x.ShipmentId
+	}
+	return ""
+}
+
+func (x *ShipmentEvent) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *ShipmentEvent) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *ShipmentEvent) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ShipmentEvent) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ShipmentEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// Order line that stock on hand can't cover
+type StockShortfall struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Requested     int32                  `protobuf:"varint,2,opt,name=requested,proto3" json:"requested,omitempty"`
+	Available     int32                  `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockShortfall) Reset() {
+	*x = StockShortfall{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockShortfall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockShortfall) ProtoMessage() {}
+
+func (x *StockShortfall) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockShortfall.ProtoReflect.Descriptor instead.
+func (*StockShortfall) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StockShortfall) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *StockShortfall) GetRequested() int32 {
+	if x != nil {
+		return x.Requested
+	}
+	return 0
+}
+
+func (x *StockShortfall) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+// Error detail attached to FailedPrecondition stock errors
+type InsufficientStock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lines         []*StockShortfall      `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsufficientStock) Reset() {
+	*x = InsufficientStock{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsufficientStock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsufficientStock) ProtoMessage() {}
+
+func (x *InsufficientStock) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsufficientStock.ProtoReflect.Descriptor instead.
+func (*InsufficientStock) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *InsufficientStock) GetLines() []*StockShortfall {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// Line on a return
+type ReturnItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ReasonCode    string                 `protobuf:"bytes,3,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"` // DAMAGED, DEFECTIVE, WRONG_ITEM, NOT_AS_DESCRIBED, NO_LONGER_NEEDED or OTHER
+	Condition     string                 `protobuf:"bytes,4,opt,name=condition,proto3" json:"condition,omitempty"`                     // Set on receipt: NEW, OPENED, DAMAGED or DEFECTIVE
+	Disposition   string                 `protobuf:"bytes,5,opt,name=disposition,proto3" json:"disposition,omitempty"`                 // RESTOCKED or QUARANTINED once received
+	Location      string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`                       // Where the goods went once received
+	Refund        *Amount                `protobuf:"bytes,7,opt,name=refund,proto3" json:"refund,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnItem) Reset() {
+	*x = ReturnItem{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnItem) ProtoMessage() {}
+
+func (x *ReturnItem) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnItem.ProtoReflect.Descriptor instead.
+func (*ReturnItem) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReturnItem) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ReturnItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReturnItem) GetReasonCode() string {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return ""
+}
+
+func (x *ReturnItem) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *ReturnItem) GetDisposition() string {
+	if x != nil {
+		return x.Disposition
+	}
+	return ""
+}
+
+func (x *ReturnItem) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ReturnItem) GetRefund() *Amount {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+// Return merchandise authorization
+type Return struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // REQUESTED, APPROVED, REJECTED or RECEIVED
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Items         []*ReturnItem          `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	RefundTotal   *Amount                `protobuf:"bytes,6,opt,name=refund_total,json=refundTotal,proto3" json:"refund_total,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Return) Reset() {
+	*x = Return{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Return) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Return) ProtoMessage() {}
+
+func (x *Return) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Return.ProtoReflect.Descriptor instead.
+func (*Return) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Return) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Return) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Return) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Return) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Return) GetItems() []*ReturnItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Return) GetRefundTotal() *Amount {
+	if x != nil {
+		return x.RefundTotal
+	}
+	return nil
+}
+
+func (x *Return) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Return) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// requests and Responses
+type CreateItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     *Amount                `protobuf:"bytes,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	TaxCategory   string                 `protobuf:"bytes,5,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateItemRequest) Reset() {
+	*x = CreateItemRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateItemRequest) ProtoMessage() {}
+
+func (x *CreateItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateItemRequest.ProtoReflect.Descriptor instead.
+func (*CreateItemRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateItemRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreateItemRequest) GetUnitPrice() *Amount {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+func (x *CreateItemRequest) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
+type CreateItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateItemResponse) Reset() {
+	*x = CreateItemResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateItemResponse) ProtoMessage() {}
+
+func (x *CreateItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateItemResponse.ProtoReflect.Descriptor instead.
+func (*CreateItemResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateItemResponse) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type UpdateItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     *Amount                `protobuf:"bytes,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	TaxCategory   string                 `protobuf:"bytes,6,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateItemRequest) Reset() {
+	*x = UpdateItemRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateItemRequest) ProtoMessage() {}
+
+func (x *UpdateItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateItemRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *UpdateItemRequest) GetUnitPrice() *Amount {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+func (x *UpdateItemRequest) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
+type UpdateItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateItemResponse) Reset() {
+	*x = UpdateItemResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateItemResponse) ProtoMessage() {}
+
+func (x *UpdateItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateItemResponse.ProtoReflect.Descriptor instead.
+func (*UpdateItemResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateItemResponse) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type DeleteItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteItemRequest) Reset() {
+	*x = DeleteItemRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteItemRequest) ProtoMessage() {}
+
+func (x *DeleteItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteItemRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteItemResponse) Reset() {
+	*x = DeleteItemResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteItemResponse) ProtoMessage() {}
+
+func (x *DeleteItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteItemResponse.ProtoReflect.Descriptor instead.
+func (*DeleteItemResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteItemResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// One row of an item import, an item with the id is updated if it exists
+// and created otherwise, a new id is generated when empty
+type ImportItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     *Amount                `protobuf:"bytes,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	TaxCategory   string                 `protobuf:"bytes,6,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`
+	DryRun        bool                   `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Validate without writing, only read from the first row
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportItemsRequest) Reset() {
+	*x = ImportItemsRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportItemsRequest) ProtoMessage() {}
+
+func (x *ImportItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportItemsRequest.ProtoReflect.Descriptor instead.
+func (*ImportItemsRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ImportItemsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportItemsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportItemsRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ImportItemsRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ImportItemsRequest) GetUnitPrice() *Amount {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+func (x *ImportItemsRequest) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
+func (x *ImportItemsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportItemError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` // 1 for the first row sent
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportItemError) Reset() {
+	*x = ImportItemError{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportItemError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportItemError) ProtoMessage() {}
+
+func (x *ImportItemError) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportItemError.ProtoReflect.Descriptor instead.
+func (*ImportItemError) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ImportItemError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportItemError) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportItemError) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ImportItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Created       int32                  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Rejected      int32                  `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	Errors        []*ImportItemError     `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`                // One per rejected row
+	DryRun        bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Nothing was written
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportItemsResponse) Reset() {
+	*x = ImportItemsResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportItemsResponse) ProtoMessage() {}
+
+func (x *ImportItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportItemsResponse.ProtoReflect.Descriptor instead.
+func (*ImportItemsResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportItemsResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportItemsResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportItemsResponse) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *ImportItemsResponse) GetErrors() []*ImportItemError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportItemsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type CreateOrderRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId         string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Items              []*OrderItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	SettlementCurrency string                 `protobuf:"bytes,3,opt,name=settlement_currency,json=settlementCurrency,proto3" json:"settlement_currency,omitempty"` // Converts every line into this currency, empty keeps the items' own
+	ShipToRegion       string                 `protobuf:"bytes,4,opt,name=ship_to_region,json=shipToRegion,proto3" json:"ship_to_region,omitempty"`                 // Ignored, the tax region comes from the ship-to address
+	CouponCodes        []string               `protobuf:"bytes,5,rep,name=coupon_codes,json=couponCodes,proto3" json:"coupon_codes,omitempty"`
+	ShipToAddressId    string                 `protobuf:"bytes,6,opt,name=ship_to_address_id,json=shipToAddressId,proto3" json:"ship_to_address_id,omitempty"` // One of the customer's shipping addresses
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateOrderRequest) Reset() {
+	*x = CreateOrderRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderRequest) ProtoMessage() {}
+
+func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateOrderRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetSettlementCurrency() string {
+	if x != nil {
+		return x.SettlementCurrency
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetShipToRegion() string {
+	if x != nil {
+		return x.ShipToRegion
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetCouponCodes() []string {
+	if x != nil {
+		return x.CouponCodes
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetShipToAddressId() string {
+	if x != nil {
+		return x.ShipToAddressId
+	}
+	return ""
+}
+
+type CreateOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderResponse) Reset() {
+	*x = CreateOrderResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderResponse) ProtoMessage() {}
+
+func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type FulfillOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Mode          FulfillmentMode        `protobuf:"varint,2,opt,name=mode,proto3,enum=supplychain.FulfillmentMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FulfillOrderRequest) Reset() {
+	*x = FulfillOrderRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FulfillOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FulfillOrderRequest) ProtoMessage() {}
+
+func (x *FulfillOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FulfillOrderRequest.ProtoReflect.Descriptor instead.
+func (*FulfillOrderRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FulfillOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *FulfillOrderRequest) GetMode() FulfillmentMode {
+	if x != nil {
+		return x.Mode
+	}
+	return FulfillmentMode_FULFILLMENT_MODE_ALL_OR_NOTHING
+}
+
+type FulfillOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Backordered   []*StockShortfall      `protobuf:"bytes,2,rep,name=backordered,proto3" json:"backordered,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FulfillOrderResponse) Reset() {
+	*x = FulfillOrderResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FulfillOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FulfillOrderResponse) ProtoMessage() {}
+
+func (x *FulfillOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FulfillOrderResponse.ProtoReflect.Descriptor instead.
+func (*FulfillOrderResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FulfillOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *FulfillOrderResponse) GetBackordered() []*StockShortfall {
+	if x != nil {
+		return x.Backordered
+	}
+	return nil
+}
+
+// A scan from a handheld. Scanning an order starts picking it and drops
+// whatever was picked for the order before, item scans count towards it.
+type PickRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ItemId        string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"` // Units scanned at once, 1 when 0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickRequest) Reset() {
+	*x = PickRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickRequest) ProtoMessage() {}
+
+func (x *PickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickRequest.ProtoReflect.Descriptor instead.
+func (*PickRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PickRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *PickRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *PickRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type PickLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemName      string                 `protobuf:"bytes,2,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"` // Units to pick, what was left to fulfill when the pick started
+	Picked        int32                  `protobuf:"varint,4,opt,name=picked,proto3" json:"picked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickLine) Reset() {
+	*x = PickLine{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickLine) ProtoMessage() {}
+
+func (x *PickLine) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickLine.ProtoReflect.Descriptor instead.
+func (*PickLine) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PickLine) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *PickLine) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *PickLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PickLine) GetPicked() int32 {
+	if x != nil {
+		return x.Picked
+	}
+	return 0
+}
+
+type PickResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        PickStatus             `protobuf:"varint,1,opt,name=status,proto3,enum=supplychain.PickStatus" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	OrderId       string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // Order being picked, empty before one is scanned
+	Lines         []*PickLine            `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+	Order         *Order                 `protobuf:"bytes,5,opt,name=order,proto3" json:"order,omitempty"`           // The fulfilled order when completed
+	Shortfalls    []*StockShortfall      `protobuf:"bytes,6,rep,name=shortfalls,proto3" json:"shortfalls,omitempty"` // Why fulfillment failed, if it was stock
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickResponse) Reset() {
+	*x = PickResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickResponse) ProtoMessage() {}
+
+func (x *PickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickResponse.ProtoReflect.Descriptor instead.
+func (*PickResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PickResponse) GetStatus() PickStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PickStatus_PICK_STATUS_STARTED
+}
+
+func (x *PickResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PickResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *PickResponse) GetLines() []*PickLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *PickResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *PickResponse) GetShortfalls() []*StockShortfall {
+	if x != nil {
+		return x.Shortfalls
+	}
+	return nil
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CancelOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResponse) ProtoMessage() {}
+
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CancelOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type CreateShipmentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	TrackingNumber string                 `protobuf:"bytes,2,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	Items          []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"` // Empty ships everything fulfilled but not yet shipped
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateShipmentRequest) Reset() {
+	*x = CreateShipmentRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShipmentRequest) ProtoMessage() {}
+
+func (x *CreateShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShipmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateShipmentRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CreateShipmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipment      *Shipment              `protobuf:"bytes,1,opt,name=shipment,proto3" json:"shipment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShipmentResponse) Reset() {
+	*x = CreateShipmentResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShipmentResponse) ProtoMessage() {}
+
+func (x *CreateShipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShipmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateShipmentResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateShipmentResponse) GetShipment() *Shipment {
+	if x != nil {
+		return x.Shipment
+	}
+	return nil
+}
+
+type UpdateShipmentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	TrackingNumber string                 `protobuf:"bytes,3,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"` // Leave empty to keep the current one
+	Location       string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Note           string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateShipmentRequest) Reset() {
+	*x = UpdateShipmentRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShipmentRequest) ProtoMessage() {}
+
+func (x *UpdateShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShipmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateShipmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateShipmentRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateShipmentRequest) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *UpdateShipmentRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *UpdateShipmentRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type UpdateShipmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipment      *Shipment              `protobuf:"bytes,1,opt,name=shipment,proto3" json:"shipment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateShipmentResponse) Reset() {
+	*x = UpdateShipmentResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateShipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShipmentResponse) ProtoMessage() {}
+
+func (x *UpdateShipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShipmentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateShipmentResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateShipmentResponse) GetShipment() *Shipment {
+	if x != nil {
+		return x.Shipment
+	}
+	return nil
+}
+
+type ListItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NameFilter    string                 `protobuf:"bytes,1,opt,name=name_filter,json=nameFilter,proto3" json:"name_filter,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListItemsRequest) Reset() {
+	*x = ListItemsRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemsRequest) ProtoMessage() {}
+
+func (x *ListItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListItemsRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListItemsRequest) GetNameFilter() string {
+	if x != nil {
+		return x.NameFilter
+	}
+	return ""
+}
+
+func (x *ListItemsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListItemsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListItemsResponse) Reset() {
+	*x = ListItemsResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemsResponse) ProtoMessage() {}
+
+func (x *ListItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListItemsResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListItemsResponse) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListItemsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRequest) ProtoMessage() {}
+
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	History       []*OrderEvent          `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderResponse) Reset() {
+	*x = GetOrderResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderResponse) ProtoMessage() {}
+
+func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *GetOrderResponse) GetHistory() []*OrderEvent {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type ListOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAfter  int64                  `protobuf:"varint,3,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`    // Unix time, inclusive
+	CreatedBefore int64                  `protobuf:"varint,4,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"` // Unix time, exclusive
+	ItemId        string                 `protobuf:"bytes,5,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	SortBy        OrderSortField         `protobuf:"varint,6,opt,name=sort_by,json=sortBy,proto3,enum=supplychain.OrderSortField" json:"sort_by,omitempty"`
+	Descending    bool                   `protobuf:"varint,7,opt,name=descending,proto3" json:"descending,omitempty"`
+	PageSize      int32                  `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // From a previous response, empty for the first page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListOrdersRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetCreatedAfter() int64 {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetCreatedBefore() int64 {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetSortBy() OrderSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return OrderSortField_ORDER_SORT_CREATED_AT
+}
+
+func (x *ListOrdersRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListOrdersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListOrdersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetShipmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShipmentRequest) Reset() {
+	*x = GetShipmentRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShipmentRequest) ProtoMessage() {}
+
+func (x *GetShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShipmentRequest.ProtoReflect.Descriptor instead.
+func (*GetShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetShipmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// WatchOrder sends the order's history after after_event_id, then every new
+// event, and ends once the order is DELIVERED or CANCELLED
+type WatchOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AfterEventId  int64                  `protobuf:"varint,2,opt,name=after_event_id,json=afterEventId,proto3" json:"after_event_id,omitempty"` // 0 for the whole history
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchOrderRequest) Reset() {
+	*x = WatchOrderRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrderRequest) ProtoMessage() {}
+
+func (x *WatchOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOrderRequest.ProtoReflect.Descriptor instead.
+func (*WatchOrderRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *WatchOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WatchOrderRequest) GetAfterEventId() int64 {
+	if x != nil {
+		return x.AfterEventId
+	}
+	return 0
+}
+
+// WatchShipment sends the tracking history after after_event_id, then every
+// new scan, and ends once the shipment is DELIVERED or RETURNED
+type WatchShipmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AfterEventId  int64                  `protobuf:"varint,2,opt,name=after_event_id,json=afterEventId,proto3" json:"after_event_id,omitempty"` // 0 for the whole history
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchShipmentRequest) Reset() {
+	*x = WatchShipmentRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchShipmentRequest) ProtoMessage() {}
+
+func (x *WatchShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchShipmentRequest.ProtoReflect.Descriptor instead.
+func (*WatchShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *WatchShipmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WatchShipmentRequest) GetAfterEventId() int64 {
+	if x != nil {
+		return x.AfterEventId
+	}
+	return 0
+}
+
+type GetShipmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipment      *Shipment              `protobuf:"bytes,1,opt,name=shipment,proto3" json:"shipment,omitempty"`
+	Events        []*ShipmentEvent       `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShipmentResponse) Reset() {
+	*x = GetShipmentResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShipmentResponse) ProtoMessage() {}
+
+func (x *GetShipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShipmentResponse.ProtoReflect.Descriptor instead.
+func (*GetShipmentResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetShipmentResponse) GetShipment() *Shipment {
+	if x != nil {
+		return x.Shipment
+	}
+	return nil
+}
+
+func (x *GetShipmentResponse) GetEvents() []*ShipmentEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type CreateReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Items         []*ReturnItem          `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"` // item_id, quantity and reason_code
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReturnRequest) Reset() {
+	*x = CreateReturnRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReturnRequest) ProtoMessage() {}
+
+func (x *CreateReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReturnRequest.ProtoReflect.Descriptor instead.
+func (*CreateReturnRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateReturnRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CreateReturnRequest) GetItems() []*ReturnItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateReturnRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CreateReturnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Return        *Return                `protobuf:"bytes,1,opt,name=return,proto3" json:"return,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReturnResponse) Reset() {
+	*x = CreateReturnResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReturnResponse) ProtoMessage() {}
+
+func (x *CreateReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReturnResponse.ProtoReflect.Descriptor instead.
+func (*CreateReturnResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateReturnResponse) GetReturn() *Return {
+	if x != nil {
+		return x.Return
+	}
+	return nil
+}
+
+type ApproveReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"` // False rejects the return
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveReturnRequest) Reset() {
+	*x = ApproveReturnRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveReturnRequest) ProtoMessage() {}
+
+func (x *ApproveReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveReturnRequest.ProtoReflect.Descriptor instead.
+func (*ApproveReturnRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ApproveReturnRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApproveReturnRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ApproveReturnRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ApproveReturnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Return        *Return                `protobuf:"bytes,1,opt,name=return,proto3" json:"return,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveReturnResponse) Reset() {
+	*x = ApproveReturnResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveReturnResponse) ProtoMessage() {}
+
+func (x *ApproveReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveReturnResponse.ProtoReflect.Descriptor instead.
+func (*ApproveReturnResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ApproveReturnResponse) GetReturn() *Return {
+	if x != nil {
+		return x.Return
+	}
+	return nil
+}
+
+type ReceiveReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Condition     string                 `protobuf:"bytes,2,opt,name=condition,proto3" json:"condition,omitempty"` // Condition of every line not listed in items
+	Items         []*ReturnItem          `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`         // item_id and condition per line
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveReturnRequest) Reset() {
+	*x = ReceiveReturnRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveReturnRequest) ProtoMessage() {}
+
+func (x *ReceiveReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveReturnRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveReturnRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ReceiveReturnRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReceiveReturnRequest) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *ReceiveReturnRequest) GetItems() []*ReturnItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ReceiveReturnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Return        *Return                `protobuf:"bytes,1,opt,name=return,proto3" json:"return,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveReturnResponse) Reset() {
+	*x = ReceiveReturnResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveReturnResponse) ProtoMessage() {}
+
+func (x *ReceiveReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveReturnResponse.ProtoReflect.Descriptor instead.
+func (*ReceiveReturnResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ReceiveReturnResponse) GetReturn() *Return {
+	if x != nil {
+		return x.Return
+	}
+	return nil
+}
+
+type GetReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReturnRequest) Reset() {
+	*x = GetReturnRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReturnRequest) ProtoMessage() {}
+
+func (x *GetReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReturnRequest.ProtoReflect.Descriptor instead.
+func (*GetReturnRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetReturnRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetReturnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Return        *Return                `protobuf:"bytes,1,opt,name=return,proto3" json:"return,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReturnResponse) Reset() {
+	*x = GetReturnResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReturnResponse) ProtoMessage() {}
+
+func (x *GetReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReturnResponse.ProtoReflect.Descriptor instead.
+func (*GetReturnResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetReturnResponse) GetReturn() *Return {
+	if x != nil {
+		return x.Return
+	}
+	return nil
+}
+
+type ListShipmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShipmentsRequest) Reset() {
+	*x = ListShipmentsRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShipmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShipmentsRequest) ProtoMessage() {}
+
+func (x *ListShipmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShipmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListShipmentsRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListShipmentsRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ListShipmentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListShipmentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListShipmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipments     []*Shipment            `protobuf:"bytes,1,rep,name=shipments,proto3" json:"shipments,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShipmentsResponse) Reset() {
+	*x = ListShipmentsResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShipmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShipmentsResponse) ProtoMessage() {}
+
+func (x *ListShipmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShipmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListShipmentsResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListShipmentsResponse) GetShipments() []*Shipment {
+	if x != nil {
+		return x.Shipments
+	}
+	return nil
+}
+
+func (x *ListShipmentsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type AddExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"` // Replaces a rate with the same pair and effective_at
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddExchangeRatesRequest) Reset() {
+	*x = AddExchangeRatesRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExchangeRatesRequest) ProtoMessage() {}
+
+func (x *AddExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*AddExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AddExchangeRatesRequest) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type AddExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Added         int32                  `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddExchangeRatesResponse) Reset() {
+	*x = AddExchangeRatesResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExchangeRatesResponse) ProtoMessage() {}
+
+func (x *AddExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*AddExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AddExchangeRatesResponse) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+type ImportExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Csv           []byte                 `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"` // base,quote,rate,effective_at rows, effective_at as RFC3339 or YYYY-MM-DD
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportExchangeRatesRequest) Reset() {
+	*x = ImportExchangeRatesRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExchangeRatesRequest) ProtoMessage() {}
+
+func (x *ImportExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*ImportExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ImportExchangeRatesRequest) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+type ImportExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Imported      int32                  `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportExchangeRatesResponse) Reset() {
+	*x = ImportExchangeRatesResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExchangeRatesResponse) ProtoMessage() {}
+
+func (x *ImportExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*ImportExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ImportExchangeRatesResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+type ListExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseCurrency  string                 `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	QuoteCurrency string                 `protobuf:"bytes,2,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
+	AsOf          int64                  `protobuf:"varint,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"` // Only the rate in effect at this unix time per pair, 0 lists the full history
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesRequest) Reset() {
+	*x = ListExchangeRatesRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesRequest) ProtoMessage() {}
+
+func (x *ListExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListExchangeRatesRequest) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *ListExchangeRatesRequest) GetQuoteCurrency() string {
+	if x != nil {
+		return x.QuoteCurrency
+	}
+	return ""
+}
+
+func (x *ListExchangeRatesRequest) GetAsOf() int64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
+type ListExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesResponse) Reset() {
+	*x = ListExchangeRatesResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesResponse) ProtoMessage() {}
+
+func (x *ListExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListExchangeRatesResponse) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type CreatePromotionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotion     *Promotion             `protobuf:"bytes,1,opt,name=promotion,proto3" json:"promotion,omitempty"` // id, usage_count, active and created_at are set by the server
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromotionRequest) Reset() {
+	*x = CreatePromotionRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionRequest) ProtoMessage() {}
+
+func (x *CreatePromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromotionRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreatePromotionRequest) GetPromotion() *Promotion {
+	if x != nil {
+		return x.Promotion
+	}
+	return nil
+}
+
+type CreatePromotionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotion     *Promotion             `protobuf:"bytes,1,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromotionResponse) Reset() {
+	*x = CreatePromotionResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionResponse) ProtoMessage() {}
+
+func (x *CreatePromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromotionResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreatePromotionResponse) GetPromotion() *Promotion {
+	if x != nil {
+		return x.Promotion
+	}
+	return nil
+}
+
+type ListPromotionsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeInactive bool                   `protobuf:"varint,1,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListPromotionsRequest) Reset() {
+	*x = ListPromotionsRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionsRequest) ProtoMessage() {}
+
+func (x *ListPromotionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPromotionsRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListPromotionsRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type ListPromotionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotions    []*Promotion           `protobuf:"bytes,1,rep,name=promotions,proto3" json:"promotions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionsResponse) Reset() {
+	*x = ListPromotionsResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionsResponse) ProtoMessage() {}
+
+func (x *ListPromotionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPromotionsResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListPromotionsResponse) GetPromotions() []*Promotion {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
+type DeactivatePromotionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivatePromotionRequest) Reset() {
+	*x = DeactivatePromotionRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivatePromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivatePromotionRequest) ProtoMessage() {}
+
+func (x *DeactivatePromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivatePromotionRequest.ProtoReflect.Descriptor instead.
+func (*DeactivatePromotionRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DeactivatePromotionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeactivatePromotionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotion     *Promotion             `protobuf:"bytes,1,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivatePromotionResponse) Reset() {
+	*x = DeactivatePromotionResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivatePromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivatePromotionResponse) ProtoMessage() {}
+
+func (x *DeactivatePromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivatePromotionResponse.ProtoReflect.Descriptor instead.
+func (*DeactivatePromotionResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DeactivatePromotionResponse) GetPromotion() *Promotion {
+	if x != nil {
+		return x.Promotion
+	}
+	return nil
+}
+
+type CreateCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Optional, e.g. an existing account number, a new id is made up when empty
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	Addresses     []*Address             `protobuf:"bytes,5,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCustomerRequest) Reset() {
+	*x = CreateCustomerRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomerRequest) ProtoMessage() {}
+
+func (x *CreateCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomerRequest.ProtoReflect.Descriptor instead.
+func (*CreateCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateCustomerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateCustomerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCustomerRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateCustomerRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateCustomerRequest) GetAddresses() []*Address {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type CreateCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCustomerResponse) Reset() {
+	*x = CreateCustomerResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomerResponse) ProtoMessage() {}
+
+func (x *CreateCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomerResponse.ProtoReflect.Descriptor instead.
+func (*CreateCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateCustomerResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+type GetCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerRequest) Reset() {
+	*x = GetCustomerRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerRequest) ProtoMessage() {}
+
+func (x *GetCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetCustomerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerResponse) Reset() {
+	*x = GetCustomerResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerResponse) ProtoMessage() {}
+
+func (x *GetCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerResponse.ProtoReflect.Descriptor instead.
+func (*GetCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetCustomerResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+type UpdateCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCustomerRequest) Reset() {
+	*x = UpdateCustomerRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomerRequest) ProtoMessage() {}
+
+func (x *UpdateCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UpdateCustomerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type UpdateCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCustomerResponse) Reset() {
+	*x = UpdateCustomerResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomerResponse) ProtoMessage() {}
+
+func (x *UpdateCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomerResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UpdateCustomerResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+type DeleteCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomerRequest) Reset() {
+	*x = DeleteCustomerRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomerRequest) ProtoMessage() {}
+
+func (x *DeleteCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DeleteCustomerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomerResponse) Reset() {
+	*x = DeleteCustomerResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomerResponse) ProtoMessage() {}
+
+func (x *DeleteCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DeleteCustomerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListCustomersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NameFilter    string                 `protobuf:"bytes,1,opt,name=name_filter,json=nameFilter,proto3" json:"name_filter,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomersRequest) Reset() {
+	*x = ListCustomersRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomersRequest) ProtoMessage() {}
+
+func (x *ListCustomersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomersRequest.ProtoReflect.Descriptor instead.
+func (*ListCustomersRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListCustomersRequest) GetNameFilter() string {
+	if x != nil {
+		return x.NameFilter
+	}
+	return ""
+}
+
+func (x *ListCustomersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCustomersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListCustomersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customers     []*Customer            `protobuf:"bytes,1,rep,name=customers,proto3" json:"customers,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomersResponse) Reset() {
+	*x = ListCustomersResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomersResponse) ProtoMessage() {}
+
+func (x *ListCustomersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomersResponse.ProtoReflect.Descriptor instead.
+func (*ListCustomersResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListCustomersResponse) GetCustomers() []*Customer {
+	if x != nil {
+		return x.Customers
+	}
+	return nil
+}
+
+func (x *ListCustomersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type AddCustomerAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Address       *Address               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCustomerAddressRequest) Reset() {
+	*x = AddCustomerAddressRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCustomerAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCustomerAddressRequest) ProtoMessage() {}
+
+func (x *AddCustomerAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCustomerAddressRequest.ProtoReflect.Descriptor instead.
+func (*AddCustomerAddressRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *AddCustomerAddressRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *AddCustomerAddressRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type AddCustomerAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCustomerAddressResponse) Reset() {
+	*x = AddCustomerAddressResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCustomerAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCustomerAddressResponse) ProtoMessage() {}
+
+func (x *AddCustomerAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCustomerAddressResponse.ProtoReflect.Descriptor instead.
+func (*AddCustomerAddressResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *AddCustomerAddressResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+type RemoveCustomerAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	AddressId     string                 `protobuf:"bytes,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCustomerAddressRequest) Reset() {
+	*x = RemoveCustomerAddressRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCustomerAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCustomerAddressRequest) ProtoMessage() {}
+
+func (x *RemoveCustomerAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCustomerAddressRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCustomerAddressRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RemoveCustomerAddressRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *RemoveCustomerAddressRequest) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+type RemoveCustomerAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCustomerAddressResponse) Reset() {
+	*x = RemoveCustomerAddressResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCustomerAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCustomerAddressResponse) ProtoMessage() {}
+
+func (x *RemoveCustomerAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCustomerAddressResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCustomerAddressResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *RemoveCustomerAddressResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+// API key as stored, the key itself is only ever shown when it is made
+type ApiKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Prefix        string                 `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"` // Start of the key, to tell keys apart
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,4,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"` // Customer a customer key acts for
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 0 for keys that never expire
+	RevokedAt     int64                  `protobuf:"varint,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"` // 0 for keys in use
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ApiKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApiKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ApiKey) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ApiKey) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ApiKey) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ApiKey) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ApiKey) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *ApiKey) GetRevokedAt() int64 {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return 0
+}
+
+type CreateApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"` // Required for customer keys
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyRequest) Reset() {
+	*x = CreateApiKeyRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyRequest) ProtoMessage() {}
+
+func (x *CreateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreateApiKeyRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type CreateApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"` // The key to hand out, it can't be shown again
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyResponse) Reset() {
+	*x = CreateApiKeyResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyResponse) ProtoMessage() {}
+
+func (x *CreateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CreateApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateApiKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ListApiKeysRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IncludeRevoked bool                   `protobuf:"varint,1,opt,name=include_revoked,json=includeRevoked,proto3" json:"include_revoked,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListApiKeysRequest) Reset() {
+	*x = ListApiKeysRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysRequest) ProtoMessage() {}
+
+func (x *ListApiKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListApiKeysRequest) GetIncludeRevoked() bool {
+	if x != nil {
+		return x.IncludeRevoked
+	}
+	return false
+}
+
+type ListApiKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeys       []*ApiKey              `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysResponse) Reset() {
+	*x = ListApiKeysResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysResponse) ProtoMessage() {}
+
+func (x *ListApiKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListApiKeysResponse) GetApiKeys() []*ApiKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+type RevokeApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyRequest) Reset() {
+	*x = RevokeApiKeyRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyRequest) ProtoMessage() {}
+
+func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *RevokeApiKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyResponse) Reset() {
+	*x = RevokeApiKeyResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyResponse) ProtoMessage() {}
+
+func (x *RevokeApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *RevokeApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+type RotateApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GraceSeconds  int64                  `protobuf:"varint,2,opt,name=grace_seconds,json=graceSeconds,proto3" json:"grace_seconds,omitempty"` // How long the old key keeps working, 0 revokes it now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateApiKeyRequest) Reset() {
+	*x = RotateApiKeyRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateApiKeyRequest) ProtoMessage() {}
+
+func (x *RotateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *RotateApiKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RotateApiKeyRequest) GetGraceSeconds() int64 {
+	if x != nil {
+		return x.GraceSeconds
+	}
+	return 0
+}
+
+type RotateApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Previous      *ApiKey                `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateApiKeyResponse) Reset() {
+	*x = RotateApiKeyResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateApiKeyResponse) ProtoMessage() {}
+
+func (x *RotateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RotateApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *RotateApiKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RotateApiKeyResponse) GetPrevious() *ApiKey {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+// Role that API keys are given, with what it may do
+type Role struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"` // resource:action, resource:* or *
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Role) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Role) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+// Permission and the RPCs it lets a caller use
+type Permission struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Methods       []string               `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Permission) Reset() {
+	*x = Permission{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Permission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Permission) ProtoMessage() {}
+
+func (x *Permission) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[95]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateItemRequest) GetQuantity() int32 {
+// Deprecated: Use Permission.ProtoReflect.Descriptor instead.
+func (*Permission) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *Permission) GetName() string {
 	if x != nil {
-		return x.Quantity
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateItemRequest) GetUnitPrice() *Amount {
+func (x *Permission) GetMethods() []string {
 	if x != nil {
-		return x.UnitPrice
+		return x.Methods
 	}
 	return nil
 }
 
-type CreateItemResponse struct {
+type ListRolesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateItemResponse) Reset() {
-	*x = CreateItemResponse{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[6]
+func (x *ListRolesRequest) Reset() {
+	*x = ListRolesRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateItemResponse) String() string {
+func (x *ListRolesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateItemResponse) ProtoMessage() {}
+func (*ListRolesRequest) ProtoMessage() {}
 
-func (x *CreateItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[6]
+func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -483,44 +6339,34 @@ func (x *CreateItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateItemResponse.ProtoReflect.Descriptor instead.
-func (*CreateItemResponse) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *CreateItemResponse) GetItem() *Item {
-	if x != nil {
-		return x.Item
-	}
-	return nil
+// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListRolesRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{96}
 }
 
-type UpdateItemRequest struct {
+type ListRolesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	UnitPrice     *Amount                `protobuf:"bytes,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	Roles         []*Role                `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	FromFile      bool                   `protobuf:"varint,2,opt,name=from_file,json=fromFile,proto3" json:"from_file,omitempty"` // Roles come from a policy file and can't be changed here
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateItemRequest) Reset() {
-	*x = UpdateItemRequest{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[7]
+func (x *ListRolesResponse) Reset() {
+	*x = ListRolesResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateItemRequest) String() string {
+func (x *ListRolesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateItemRequest) ProtoMessage() {}
+func (*ListRolesResponse) ProtoMessage() {}
 
-func (x *UpdateItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[7]
+func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,68 +6377,91 @@ func (x *UpdateItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateItemRequest.ProtoReflect.Descriptor instead.
-func (*UpdateItemRequest) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListRolesResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *UpdateItemRequest) GetId() string {
+func (x *ListRolesResponse) GetRoles() []*Role {
 	if x != nil {
-		return x.Id
+		return x.Roles
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateItemRequest) GetName() string {
+func (x *ListRolesResponse) GetFromFile() bool {
 	if x != nil {
-		return x.Name
+		return x.FromFile
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateItemRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+type PutRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"` // Created, or replaces the role with the same name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateItemRequest) GetQuantity() int32 {
+func (x *PutRoleRequest) Reset() {
+	*x = PutRoleRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutRoleRequest) ProtoMessage() {}
+
+func (x *PutRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[98]
 	if x != nil {
-		return x.Quantity
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateItemRequest) GetUnitPrice() *Amount {
+// Deprecated: Use PutRoleRequest.ProtoReflect.Descriptor instead.
+func (*PutRoleRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *PutRoleRequest) GetRole() *Role {
 	if x != nil {
-		return x.UnitPrice
+		return x.Role
 	}
 	return nil
 }
 
-type UpdateItemResponse struct {
+type PutRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateItemResponse) Reset() {
-	*x = UpdateItemResponse{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[8]
+func (x *PutRoleResponse) Reset() {
+	*x = PutRoleResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateItemResponse) String() string {
+func (x *PutRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateItemResponse) ProtoMessage() {}
+func (*PutRoleResponse) ProtoMessage() {}
 
-func (x *UpdateItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[8]
+func (x *PutRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,40 +6472,40 @@ func (x *UpdateItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateItemResponse.ProtoReflect.Descriptor instead.
-func (*UpdateItemResponse) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use PutRoleResponse.ProtoReflect.Descriptor instead.
+func (*PutRoleResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *UpdateItemResponse) GetItem() *Item {
+func (x *PutRoleResponse) GetRole() *Role {
 	if x != nil {
-		return x.Item
+		return x.Role
 	}
 	return nil
 }
 
-type DeleteItemRequest struct {
+type DeleteRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteItemRequest) Reset() {
-	*x = DeleteItemRequest{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[9]
+func (x *DeleteRoleRequest) Reset() {
+	*x = DeleteRoleRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteItemRequest) String() string {
+func (x *DeleteRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteItemRequest) ProtoMessage() {}
+func (*DeleteRoleRequest) ProtoMessage() {}
 
-func (x *DeleteItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[9]
+func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -647,40 +6516,40 @@ func (x *DeleteItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteItemRequest.ProtoReflect.Descriptor instead.
-func (*DeleteItemRequest) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DeleteRoleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *DeleteItemRequest) GetId() string {
+func (x *DeleteRoleRequest) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-type DeleteItemResponse struct {
+type DeleteRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteItemResponse) Reset() {
-	*x = DeleteItemResponse{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[10]
+func (x *DeleteRoleResponse) Reset() {
+	*x = DeleteRoleResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteItemResponse) String() string {
+func (x *DeleteRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteItemResponse) ProtoMessage() {}
+func (*DeleteRoleResponse) ProtoMessage() {}
 
-func (x *DeleteItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[10]
+func (x *DeleteRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,41 +6560,39 @@ func (x *DeleteItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteItemResponse.ProtoReflect.Descriptor instead.
-func (*DeleteItemResponse) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DeleteRoleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoleResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *DeleteItemResponse) GetSuccess() bool {
+func (x *DeleteRoleResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type CreateOrderRequest struct {
+type ListPermissionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Items         []*OrderItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOrderRequest) Reset() {
-	*x = CreateOrderRequest{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[11]
+func (x *ListPermissionsRequest) Reset() {
+	*x = ListPermissionsRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrderRequest) String() string {
+func (x *ListPermissionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrderRequest) ProtoMessage() {}
+func (*ListPermissionsRequest) ProtoMessage() {}
 
-func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[11]
+func (x *ListPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -736,47 +6603,33 @@ func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
-func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *CreateOrderRequest) GetCustomerId() string {
-	if x != nil {
-		return x.CustomerId
-	}
-	return ""
-}
-
-func (x *CreateOrderRequest) GetItems() []*OrderItem {
-	if x != nil {
-		return x.Items
-	}
-	return nil
+// Deprecated: Use ListPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{102}
 }
 
-type CreateOrderResponse struct {
+type ListPermissionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Permissions   []*Permission          `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOrderResponse) Reset() {
-	*x = CreateOrderResponse{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[12]
+func (x *ListPermissionsResponse) Reset() {
+	*x = ListPermissionsResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrderResponse) String() string {
+func (x *ListPermissionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrderResponse) ProtoMessage() {}
+func (*ListPermissionsResponse) ProtoMessage() {}
 
-func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[12]
+func (x *ListPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -787,40 +6640,48 @@ func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
-func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *CreateOrderResponse) GetOrder() *Order {
+func (x *ListPermissionsResponse) GetPermissions() []*Permission {
 	if x != nil {
-		return x.Order
+		return x.Permissions
 	}
 	return nil
 }
 
-type FulfillOrderRequest struct {
+// A change to an item, events are sent in the order they happened
+type InventoryEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Type          InventoryEventType     `protobuf:"varint,1,opt,name=type,proto3,enum=supplychain.InventoryEventType" json:"type,omitempty"`
+	ItemId        string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`                                // On hand after the change
+	QuantityDelta int32                  `protobuf:"varint,5,opt,name=quantity_delta,json=quantityDelta,proto3" json:"quantity_delta,omitempty"` // Change to the on hand quantity
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                                     // What caused it, e.g. FulfillOrder {order id}
+	OccurredAt    int64                  `protobuf:"varint,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,8,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // Pass back to WatchInventory to carry on after this event
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FulfillOrderRequest) Reset() {
-	*x = FulfillOrderRequest{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[13]
+func (x *InventoryEvent) Reset() {
+	*x = InventoryEvent{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FulfillOrderRequest) String() string {
+func (x *InventoryEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FulfillOrderRequest) ProtoMessage() {}
+func (*InventoryEvent) ProtoMessage() {}
 
-func (x *FulfillOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[13]
+func (x *InventoryEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -831,85 +6692,91 @@ func (x *FulfillOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FulfillOrderRequest.ProtoReflect.Descriptor instead.
-func (*FulfillOrderRequest) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use InventoryEvent.ProtoReflect.Descriptor instead.
+func (*InventoryEvent) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *FulfillOrderRequest) GetOrderId() string {
+func (x *InventoryEvent) GetType() InventoryEventType {
 	if x != nil {
-		return x.OrderId
+		return x.Type
 	}
-	return ""
+	return InventoryEventType_INVENTORY_EVENT_ITEM_CREATED
 }
 
-type FulfillOrderResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *InventoryEvent) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
 }
 
-func (x *FulfillOrderResponse) Reset() {
-	*x = FulfillOrderResponse{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[14]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *InventoryEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *FulfillOrderResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *InventoryEvent) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
 }
 
-func (*FulfillOrderResponse) ProtoMessage() {}
+func (x *InventoryEvent) GetQuantityDelta() int32 {
+	if x != nil {
+		return x.QuantityDelta
+	}
+	return 0
+}
 
-func (x *FulfillOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[14]
+func (x *InventoryEvent) GetReason() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Reason
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use FulfillOrderResponse.ProtoReflect.Descriptor instead.
-func (*FulfillOrderResponse) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{14}
+func (x *InventoryEvent) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
 }
 
-func (x *FulfillOrderResponse) GetOrder() *Order {
+func (x *InventoryEvent) GetResumeToken() string {
 	if x != nil {
-		return x.Order
+		return x.ResumeToken
 	}
-	return nil
+	return ""
 }
 
-type CreateShipmentRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	TrackingNumber string                 `protobuf:"bytes,2,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type WatchInventoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemIds       []string               `protobuf:"bytes,1,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`             // Only these items, all when empty
+	NameFilter    string                 `protobuf:"bytes,2,opt,name=name_filter,json=nameFilter,proto3" json:"name_filter,omitempty"`    // Only items whose name contains this
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // Replay the events after this one first, live events only when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateShipmentRequest) Reset() {
-	*x = CreateShipmentRequest{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[15]
+func (x *WatchInventoryRequest) Reset() {
+	*x = WatchInventoryRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateShipmentRequest) String() string {
+func (x *WatchInventoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateShipmentRequest) ProtoMessage() {}
+func (*WatchInventoryRequest) ProtoMessage() {}
 
-func (x *CreateShipmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[15]
+func (x *WatchInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -920,47 +6787,55 @@ func (x *CreateShipmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateShipmentRequest.ProtoReflect.Descriptor instead.
-func (*CreateShipmentRequest) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use WatchInventoryRequest.ProtoReflect.Descriptor instead.
+func (*WatchInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *WatchInventoryRequest) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
 }
 
-func (x *CreateShipmentRequest) GetOrderId() string {
+func (x *WatchInventoryRequest) GetNameFilter() string {
 	if x != nil {
-		return x.OrderId
+		return x.NameFilter
 	}
 	return ""
 }
 
-func (x *CreateShipmentRequest) GetTrackingNumber() string {
+func (x *WatchInventoryRequest) GetResumeToken() string {
 	if x != nil {
-		return x.TrackingNumber
+		return x.ResumeToken
 	}
 	return ""
 }
 
-type CreateShipmentResponse struct {
+type BatchUpdateItemsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Shipment      *Shipment              `protobuf:"bytes,1,opt,name=shipment,proto3" json:"shipment,omitempty"`
+	Items         []*UpdateItemRequest   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // Up to 500
+	Mode          BatchMode              `protobuf:"varint,2,opt,name=mode,proto3,enum=supplychain.BatchMode" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateShipmentResponse) Reset() {
-	*x = CreateShipmentResponse{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[16]
+func (x *BatchUpdateItemsRequest) Reset() {
+	*x = BatchUpdateItemsRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateShipmentResponse) String() string {
+func (x *BatchUpdateItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateShipmentResponse) ProtoMessage() {}
+func (*BatchUpdateItemsRequest) ProtoMessage() {}
 
-func (x *CreateShipmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[16]
+func (x *BatchUpdateItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -971,42 +6846,53 @@ func (x *CreateShipmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateShipmentResponse.ProtoReflect.Descriptor instead.
-func (*CreateShipmentResponse) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use BatchUpdateItemsRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateItemsRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *CreateShipmentResponse) GetShipment() *Shipment {
+func (x *BatchUpdateItemsRequest) GetItems() []*UpdateItemRequest {
 	if x != nil {
-		return x.Shipment
+		return x.Items
 	}
 	return nil
 }
 
-type UpdateShipmentRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	TrackingNumber string                 `protobuf:"bytes,3,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *BatchUpdateItemsRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_MODE_ALL_OR_NOTHING
 }
 
-func (x *UpdateShipmentRequest) Reset() {
-	*x = UpdateShipmentRequest{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[17]
+// Every batch result has a code, OK when the entry worked or the gRPC code
+// it failed with like NotFound. In all-or-nothing mode entries that worked
+// come back Aborted when another one failed.
+type BatchItemResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Item          *Item                  `protobuf:"bytes,4,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItemResult) Reset() {
+	*x = BatchItemResult{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateShipmentRequest) String() string {
+func (x *BatchItemResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateShipmentRequest) ProtoMessage() {}
+func (*BatchItemResult) ProtoMessage() {}
 
-func (x *UpdateShipmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[17]
+func (x *BatchItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1017,54 +6903,63 @@ func (x *UpdateShipmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateShipmentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateShipmentRequest) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use BatchItemResult.ProtoReflect.Descriptor instead.
+func (*BatchItemResult) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *UpdateShipmentRequest) GetId() string {
+func (x *BatchItemResult) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateShipmentRequest) GetStatus() string {
+func (x *BatchItemResult) GetCode() string {
 	if x != nil {
-		return x.Status
+		return x.Code
 	}
 	return ""
 }
 
-func (x *UpdateShipmentRequest) GetTrackingNumber() string {
+func (x *BatchItemResult) GetError() string {
 	if x != nil {
-		return x.TrackingNumber
+		return x.Error
 	}
 	return ""
 }
 
-type UpdateShipmentResponse struct {
+func (x *BatchItemResult) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type BatchUpdateItemsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Shipment      *Shipment              `protobuf:"bytes,1,opt,name=shipment,proto3" json:"shipment,omitempty"`
+	Results       []*BatchItemResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // One per entry, in the same order
+	Succeeded     int32                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateShipmentResponse) Reset() {
-	*x = UpdateShipmentResponse{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[18]
+func (x *BatchUpdateItemsResponse) Reset() {
+	*x = BatchUpdateItemsResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateShipmentResponse) String() string {
+func (x *BatchUpdateItemsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateShipmentResponse) ProtoMessage() {}
+func (*BatchUpdateItemsResponse) ProtoMessage() {}
 
-func (x *UpdateShipmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[18]
+func (x *BatchUpdateItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1075,42 +6970,55 @@ func (x *UpdateShipmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateShipmentResponse.ProtoReflect.Descriptor instead.
-func (*UpdateShipmentResponse) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use BatchUpdateItemsResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateItemsResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *UpdateShipmentResponse) GetShipment() *Shipment {
+func (x *BatchUpdateItemsResponse) GetResults() []*BatchItemResult {
 	if x != nil {
-		return x.Shipment
+		return x.Results
 	}
 	return nil
 }
 
-type ListItemsRequest struct {
+func (x *BatchUpdateItemsResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BatchUpdateItemsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type BatchGetOrdersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NameFilter    string                 `protobuf:"bytes,1,opt,name=name_filter,json=nameFilter,proto3" json:"name_filter,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"` // Up to 500
+	Mode          BatchMode              `protobuf:"varint,2,opt,name=mode,proto3,enum=supplychain.BatchMode" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListItemsRequest) Reset() {
-	*x = ListItemsRequest{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[19]
+func (x *BatchGetOrdersRequest) Reset() {
+	*x = BatchGetOrdersRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListItemsRequest) String() string {
+func (x *BatchGetOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListItemsRequest) ProtoMessage() {}
+func (*BatchGetOrdersRequest) ProtoMessage() {}
 
-func (x *ListItemsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[19]
+func (x *BatchGetOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1121,55 +7029,51 @@ func (x *ListItemsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListItemsRequest.ProtoReflect.Descriptor instead.
-func (*ListItemsRequest) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *ListItemsRequest) GetNameFilter() string {
-	if x != nil {
-		return x.NameFilter
-	}
-	return ""
+// Deprecated: Use BatchGetOrdersRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *ListItemsRequest) GetPage() int32 {
+func (x *BatchGetOrdersRequest) GetIds() []string {
 	if x != nil {
-		return x.Page
+		return x.Ids
 	}
-	return 0
+	return nil
 }
 
-func (x *ListItemsRequest) GetPageSize() int32 {
+func (x *BatchGetOrdersRequest) GetMode() BatchMode {
 	if x != nil {
-		return x.PageSize
+		return x.Mode
 	}
-	return 0
+	return BatchMode_BATCH_MODE_ALL_OR_NOTHING
 }
 
-type ListItemsResponse struct {
+type BatchOrderResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Order         *Order                 `protobuf:"bytes,4,opt,name=order,proto3" json:"order,omitempty"`
+	History       []*OrderEvent          `protobuf:"bytes,5,rep,name=history,proto3" json:"history,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListItemsResponse) Reset() {
-	*x = ListItemsResponse{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[20]
+func (x *BatchOrderResult) Reset() {
+	*x = BatchOrderResult{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListItemsResponse) String() string {
+func (x *BatchOrderResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListItemsResponse) ProtoMessage() {}
+func (*BatchOrderResult) ProtoMessage() {}
 
-func (x *ListItemsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[20]
+func (x *BatchOrderResult) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1180,47 +7084,70 @@ func (x *ListItemsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListItemsResponse.ProtoReflect.Descriptor instead.
-func (*ListItemsResponse) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use BatchOrderResult.ProtoReflect.Descriptor instead.
+func (*BatchOrderResult) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *ListItemsResponse) GetItems() []*Item {
+func (x *BatchOrderResult) GetId() string {
 	if x != nil {
-		return x.Items
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BatchOrderResult) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BatchOrderResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BatchOrderResult) GetOrder() *Order {
+	if x != nil {
+		return x.Order
 	}
 	return nil
 }
 
-func (x *ListItemsResponse) GetTotal() int32 {
+func (x *BatchOrderResult) GetHistory() []*OrderEvent {
 	if x != nil {
-		return x.Total
+		return x.History
 	}
-	return 0
+	return nil
 }
 
-type GetOrderRequest struct {
+type BatchGetOrdersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Results       []*BatchOrderResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderRequest) Reset() {
-	*x = GetOrderRequest{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[21]
+func (x *BatchGetOrdersResponse) Reset() {
+	*x = BatchGetOrdersResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderRequest) String() string {
+func (x *BatchGetOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderRequest) ProtoMessage() {}
+func (*BatchGetOrdersResponse) ProtoMessage() {}
 
-func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[21]
+func (x *BatchGetOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1231,40 +7158,55 @@ func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use BatchGetOrdersResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *GetOrderRequest) GetId() string {
+func (x *BatchGetOrdersResponse) GetResults() []*BatchOrderResult {
 	if x != nil {
-		return x.Id
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-type GetOrderResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+func (x *BatchGetOrdersResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BatchGetOrdersResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type BatchUpdateShipmentsRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Shipments     []*UpdateShipmentRequest `protobuf:"bytes,1,rep,name=shipments,proto3" json:"shipments,omitempty"` // Up to 500
+	Mode          BatchMode                `protobuf:"varint,2,opt,name=mode,proto3,enum=supplychain.BatchMode" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderResponse) Reset() {
-	*x = GetOrderResponse{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[22]
+func (x *BatchUpdateShipmentsRequest) Reset() {
+	*x = BatchUpdateShipmentsRequest{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderResponse) String() string {
+func (x *BatchUpdateShipmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderResponse) ProtoMessage() {}
+func (*BatchUpdateShipmentsRequest) ProtoMessage() {}
 
-func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[22]
+func (x *BatchUpdateShipmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1275,42 +7217,50 @@ func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderResponse) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use BatchUpdateShipmentsRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateShipmentsRequest) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *GetOrderResponse) GetOrder() *Order {
+func (x *BatchUpdateShipmentsRequest) GetShipments() []*UpdateShipmentRequest {
 	if x != nil {
-		return x.Order
+		return x.Shipments
 	}
 	return nil
 }
 
-type ListShipmentsRequest struct {
+func (x *BatchUpdateShipmentsRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_MODE_ALL_OR_NOTHING
+}
+
+type BatchShipmentResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Shipment      *Shipment              `protobuf:"bytes,4,opt,name=shipment,proto3" json:"shipment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListShipmentsRequest) Reset() {
-	*x = ListShipmentsRequest{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[23]
+func (x *BatchShipmentResult) Reset() {
+	*x = BatchShipmentResult{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListShipmentsRequest) String() string {
+func (x *BatchShipmentResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListShipmentsRequest) ProtoMessage() {}
+func (*BatchShipmentResult) ProtoMessage() {}
 
-func (x *ListShipmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[23]
+func (x *BatchShipmentResult) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1321,55 +7271,63 @@ func (x *ListShipmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListShipmentsRequest.ProtoReflect.Descriptor instead.
-func (*ListShipmentsRequest) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use BatchShipmentResult.ProtoReflect.Descriptor instead.
+func (*BatchShipmentResult) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *ListShipmentsRequest) GetOrderId() string {
+func (x *BatchShipmentResult) GetId() string {
 	if x != nil {
-		return x.OrderId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListShipmentsRequest) GetPage() int32 {
+func (x *BatchShipmentResult) GetCode() string {
 	if x != nil {
-		return x.Page
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-func (x *ListShipmentsRequest) GetPageSize() int32 {
+func (x *BatchShipmentResult) GetError() string {
 	if x != nil {
-		return x.PageSize
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-type ListShipmentsResponse struct {
+func (x *BatchShipmentResult) GetShipment() *Shipment {
+	if x != nil {
+		return x.Shipment
+	}
+	return nil
+}
+
+type BatchUpdateShipmentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Shipments     []*Shipment            `protobuf:"bytes,1,rep,name=shipments,proto3" json:"shipments,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Results       []*BatchShipmentResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListShipmentsResponse) Reset() {
-	*x = ListShipmentsResponse{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[24]
+func (x *BatchUpdateShipmentsResponse) Reset() {
+	*x = BatchUpdateShipmentsResponse{}
+	mi := &file_supplychain_supplychain_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListShipmentsResponse) String() string {
+func (x *BatchUpdateShipmentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListShipmentsResponse) ProtoMessage() {}
+func (*BatchUpdateShipmentsResponse) ProtoMessage() {}
 
-func (x *ListShipmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[24]
+func (x *BatchUpdateShipmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_supplychain_supplychain_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1380,28 +7338,35 @@ func (x *ListShipmentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListShipmentsResponse.ProtoReflect.Descriptor instead.
-func (*ListShipmentsResponse) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use BatchUpdateShipmentsResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateShipmentsResponse) Descriptor() ([]byte, []int) {
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *ListShipmentsResponse) GetShipments() []*Shipment {
+func (x *BatchUpdateShipmentsResponse) GetResults() []*BatchShipmentResult {
 	if x != nil {
-		return x.Shipments
+		return x.Results
 	}
 	return nil
 }
 
-func (x *ListShipmentsResponse) GetTotal() int32 {
+func (x *BatchUpdateShipmentsResponse) GetSucceeded() int32 {
 	if x != nil {
-		return x.Total
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BatchUpdateShipmentsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
 	}
 	return 0
 }
 
 type AuditLogsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ApiKey        string                 `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	ApiKey        string                 `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"` // Key id, as listed by ListApiKeys
 	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -1410,7 +7375,7 @@ type AuditLogsRequest struct {
 
 func (x *AuditLogsRequest) Reset() {
 	*x = AuditLogsRequest{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[25]
+	mi := &file_supplychain_supplychain_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1422,7 +7387,7 @@ func (x *AuditLogsRequest) String() string {
 func (*AuditLogsRequest) ProtoMessage() {}
 
 func (x *AuditLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[25]
+	mi := &file_supplychain_supplychain_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1435,7 +7400,7 @@ func (x *AuditLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditLogsRequest.ProtoReflect.Descriptor instead.
 func (*AuditLogsRequest) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{25}
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *AuditLogsRequest) GetApiKey() string {
@@ -1473,7 +7438,7 @@ type AuditLog struct {
 
 func (x *AuditLog) Reset() {
 	*x = AuditLog{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[26]
+	mi := &file_supplychain_supplychain_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1485,7 +7450,7 @@ func (x *AuditLog) String() string {
 func (*AuditLog) ProtoMessage() {}
 
 func (x *AuditLog) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[26]
+	mi := &file_supplychain_supplychain_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1498,7 +7463,7 @@ func (x *AuditLog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
 func (*AuditLog) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{26}
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *AuditLog) GetId() int64 {
@@ -1553,7 +7518,7 @@ type AuditLogsResponse struct {
 
 func (x *AuditLogsResponse) Reset() {
 	*x = AuditLogsResponse{}
-	mi := &file_supplychain_supplychain_proto_msgTypes[27]
+	mi := &file_supplychain_supplychain_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1565,7 +7530,7 @@ func (x *AuditLogsResponse) String() string {
 func (*AuditLogsResponse) ProtoMessage() {}
 
 func (x *AuditLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_supplychain_supplychain_proto_msgTypes[27]
+	mi := &file_supplychain_supplychain_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1578,7 +7543,7 @@ func (x *AuditLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditLogsResponse.ProtoReflect.Descriptor instead.
 func (*AuditLogsResponse) Descriptor() ([]byte, []int) {
-	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{27}
+	return file_supplychain_supplychain_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *AuditLogsResponse) GetLogs() []*AuditLog {
@@ -1597,241 +7562,628 @@ func (x *AuditLogsResponse) GetTotal() int32 {
 
 var File_supplychain_supplychain_proto protoreflect.FileDescriptor
 
-var file_supplychain_supplychain_proto_rawDesc = string([]byte{
-	0x0a, 0x1d, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x75,
-	0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x0b, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x22, 0x5f, 0x0a, 0x06,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70,
-	0x6c, 0x61, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xbb, 0x01,
-	0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x0a, 0x75, 0x6e, 0x69, 0x74,
-	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73,
-	0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc8, 0x01, 0x0a, 0x05,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x40, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
-	0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x95, 0x01, 0x0a, 0x08, 0x53, 0x68, 0x69,
-	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x63,
-	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x22, 0x99, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x0a, 0x75, 0x6e, 0x69, 0x74,
-	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73,
-	0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x3b, 0x0a, 0x12,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0xa9, 0x01, 0x0a, 0x11, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x32, 0x0a, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x74,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x3b, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x69,
-	0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x75, 0x70, 0x70,
-	0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74,
-	0x65, 0x6d, 0x22, 0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x63, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2c,
-	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3f, 0x0a, 0x13,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x30, 0x0a,
-	0x13, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x40, 0x0a, 0x14, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x22, 0x5b, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e,
-	0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x4b,
-	0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x68, 0x69, 0x70,
-	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x75, 0x70,
-	0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x08, 0x73, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x68, 0x0a, 0x15, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f,
-	0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x4b, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x31, 0x0a, 0x08, 0x73, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
-	0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x68, 0x69, 0x70, 0x6d, 0x65,
-	0x6e, 0x74, 0x22, 0x64, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d,
-	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70,
-	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x52, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
-	0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
-	0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x21, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x3c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x62, 0x0a,
-	0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
-	0x65, 0x22, 0x62, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x73, 0x68,
-	0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x68, 0x69, 0x70,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x73, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x5c, 0x0a, 0x10, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f,
-	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x69,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b,
-	0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x08, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x54, 0x0a, 0x11, 0x41, 0x75,
-	0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x29, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x41, 0x75, 0x64, 0x69,
-	0x74, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x32, 0x90, 0x07, 0x0a, 0x0b, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x12, 0x4d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1e,
-	0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x4d, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1e, 0x2e,
-	0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d,
-	0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1e, 0x2e, 0x73,
-	0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73,
-	0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a,
-	0x09, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x75, 0x70,
-	0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x75, 0x70, 0x70,
-	0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c,
-	0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x75, 0x70, 0x70,
-	0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x46,
-	0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x73, 0x75,
-	0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c,
-	0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
-	0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x46, 0x75, 0x6c, 0x66,
-	0x69, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x47, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x73,
-	0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x75, 0x70,
-	0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x73, 0x75,
-	0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x23, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68,
-	0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x75, 0x70,
-	0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x56, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x21, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x41, 0x75, 0x64, 0x69, 0x74,
-	0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x53, 0x63, 0x72, 0x69, 0x6d, 0x7a, 0x61, 0x79, 0x2f, 0x73, 0x75, 0x70, 0x70, 0x6c,
-	0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-})
+const file_supplychain_supplychain_proto_rawDesc = "" +
+	"\n" +
+	"\x1dsupplychain/supplychain.proto\x12\vsupplychain\"_\n" +
+	"\x06Amount\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x03R\x05value\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12#\n" +
+	"\rdisplay_value\x18\x03 \x01(\tR\fdisplayValue\"\xba\x02\n" +
+	"\x04Item\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x122\n" +
+	"\n" +
+	"unit_price\x18\x05 \x01(\v2\x13.supplychain.AmountR\tunitPrice\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\x12+\n" +
+	"\x11reserved_quantity\x18\a \x01(\x05R\x10reservedQuantity\x12-\n" +
+	"\x12available_quantity\x18\b \x01(\x05R\x11availableQuantity\x12!\n" +
+	"\ftax_category\x18\t \x01(\tR\vtaxCategory\"\xb8\x04\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12,\n" +
+	"\x05items\x18\x03 \x03(\v2\x16.supplychain.OrderItemR\x05items\x12)\n" +
+	"\x05total\x18\x04 \x01(\v2\x13.supplychain.AmountR\x05total\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12/\n" +
+	"\bsubtotal\x18\a \x01(\v2\x13.supplychain.AmountR\bsubtotal\x120\n" +
+	"\ttax_total\x18\b \x01(\v2\x13.supplychain.AmountR\btaxTotal\x12$\n" +
+	"\x0eship_to_region\x18\t \x01(\tR\fshipToRegion\x12)\n" +
+	"\x10tax_jurisdiction\x18\n" +
+	" \x01(\tR\x0ftaxJurisdiction\x12,\n" +
+	"\x12prices_include_tax\x18\v \x01(\bR\x10pricesIncludeTax\x12:\n" +
+	"\x0ediscount_total\x18\f \x01(\v2\x13.supplychain.AmountR\rdiscountTotal\x12!\n" +
+	"\fcoupon_codes\x18\r \x03(\tR\vcouponCodes\x12-\n" +
+	"\aship_to\x18\x0e \x01(\v2\x14.supplychain.AddressR\x06shipTo\"\xee\x01\n" +
+	"\aAddress\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x18.supplychain.AddressTypeR\x04type\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05line1\x18\x04 \x01(\tR\x05line1\x12\x14\n" +
+	"\x05line2\x18\x05 \x01(\tR\x05line2\x12\x12\n" +
+	"\x04city\x18\x06 \x01(\tR\x04city\x12\x16\n" +
+	"\x06region\x18\a \x01(\tR\x06region\x12\x1f\n" +
+	"\vpostal_code\x18\b \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\t \x01(\tR\acountry\"\xcc\x01\n" +
+	"\bCustomer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x122\n" +
+	"\taddresses\x18\x05 \x03(\v2\x14.supplychain.AddressR\taddresses\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\x03R\tupdatedAt\"\x95\x06\n" +
+	"\tOrderItem\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12-\n" +
+	"\x12fulfilled_quantity\x18\x03 \x01(\x05R\x11fulfilledQuantity\x12)\n" +
+	"\x10shipped_quantity\x18\x04 \x01(\x05R\x0fshippedQuantity\x121\n" +
+	"\x14outstanding_quantity\x18\x05 \x01(\x05R\x13outstandingQuantity\x122\n" +
+	"\n" +
+	"unit_price\x18\x06 \x01(\v2\x13.supplychain.AmountR\tunitPrice\x12\x12\n" +
+	"\x04name\x18\a \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x122\n" +
+	"\n" +
+	"line_total\x18\t \x01(\v2\x13.supplychain.AmountR\tlineTotal\x12C\n" +
+	"\x13original_unit_price\x18\n" +
+	" \x01(\v2\x13.supplychain.AmountR\x11originalUnitPrice\x12C\n" +
+	"\x13original_line_total\x18\v \x01(\v2\x13.supplychain.AmountR\x11originalLineTotal\x12#\n" +
+	"\rexchange_rate\x18\f \x01(\tR\fexchangeRate\x12*\n" +
+	"\x11rate_effective_at\x18\r \x01(\x03R\x0frateEffectiveAt\x12%\n" +
+	"\x03tax\x18\x0e \x01(\v2\x13.supplychain.AmountR\x03tax\x12\x19\n" +
+	"\btax_rate\x18\x0f \x01(\tR\ataxRate\x12!\n" +
+	"\ftax_category\x18\x10 \x01(\tR\vtaxCategory\x127\n" +
+	"\tdiscounts\x18\x11 \x03(\v2\x19.supplychain.LineDiscountR\tdiscounts\x12/\n" +
+	"\bdiscount\x18\x12 \x01(\v2\x13.supplychain.AmountR\bdiscount\"\x86\x01\n" +
+	"\fLineDiscount\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\tR\vpromotionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12+\n" +
+	"\x06amount\x18\x04 \x01(\v2\x13.supplychain.AmountR\x06amount\"R\n" +
+	"\fDiscountTier\x12!\n" +
+	"\fmin_quantity\x18\x01 \x01(\x05R\vminQuantity\x12\x1f\n" +
+	"\vpercent_off\x18\x02 \x01(\tR\n" +
+	"percentOff\"\x89\x04\n" +
+	"\tPromotion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12.\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1a.supplychain.PromotionTypeR\x04type\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\tR\x04code\x12\x19\n" +
+	"\bitem_ids\x18\x05 \x03(\tR\aitemIds\x12\x1f\n" +
+	"\vpercent_off\x18\x06 \x01(\tR\n" +
+	"percentOff\x122\n" +
+	"\n" +
+	"amount_off\x18\a \x01(\v2\x13.supplychain.AmountR\tamountOff\x12!\n" +
+	"\fbuy_quantity\x18\b \x01(\x05R\vbuyQuantity\x12!\n" +
+	"\fget_quantity\x18\t \x01(\x05R\vgetQuantity\x12/\n" +
+	"\x05tiers\x18\n" +
+	" \x03(\v2\x19.supplychain.DiscountTierR\x05tiers\x12\x1b\n" +
+	"\tstarts_at\x18\v \x01(\x03R\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\f \x01(\x03R\x06endsAt\x12\x1f\n" +
+	"\vusage_limit\x18\r \x01(\x05R\n" +
+	"usageLimit\x12\x1f\n" +
+	"\vusage_count\x18\x0e \x01(\x05R\n" +
+	"usageCount\x12\x16\n" +
+	"\x06active\x18\x0f \x01(\bR\x06active\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\x03R\tcreatedAt\"\x91\x01\n" +
+	"\fExchangeRate\x12#\n" +
+	"\rbase_currency\x18\x01 \x01(\tR\fbaseCurrency\x12%\n" +
+	"\x0equote_currency\x18\x02 \x01(\tR\rquoteCurrency\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\x12!\n" +
+	"\feffective_at\x18\x04 \x01(\x03R\veffectiveAt\"\xbb\x01\n" +
+	"\n" +
+	"OrderEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1f\n" +
+	"\vfrom_status\x18\x04 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x05 \x01(\tR\btoStatus\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x12\x1c\n" +
+	"\ttimestamp\x18\a \x01(\x03R\ttimestamp\"\xf2\x01\n" +
+	"\bShipment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12'\n" +
+	"\x0ftracking_number\x18\x04 \x01(\tR\x0etrackingNumber\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\x12,\n" +
+	"\x05items\x18\x06 \x03(\v2\x16.supplychain.OrderItemR\x05items\x12-\n" +
+	"\aship_to\x18\a \x01(\v2\x14.supplychain.AddressR\x06shipTo\"\xcc\x01\n" +
+	"\rShipmentEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vshipment_id\x18\x02 \x01(\tR\n" +
+	"shipmentId\x12\x1f\n" +
+	"\vfrom_status\x18\x03 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x04 \x01(\tR\btoStatus\x12\x1a\n" +
+	"\blocation\x18\x05 \x01(\tR\blocation\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x12\x1c\n" +
+	"\ttimestamp\x18\a \x01(\x03R\ttimestamp\"e\n" +
+	"\x0eStockShortfall\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1c\n" +
+	"\trequested\x18\x02 \x01(\x05R\trequested\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\x05R\tavailable\"F\n" +
+	"\x11InsufficientStock\x121\n" +
+	"\x05lines\x18\x01 \x03(\v2\x1b.supplychain.StockShortfallR\x05lines\"\xeb\x01\n" +
+	"\n" +
+	"ReturnItem\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x1f\n" +
+	"\vreason_code\x18\x03 \x01(\tR\n" +
+	"reasonCode\x12\x1c\n" +
+	"\tcondition\x18\x04 \x01(\tR\tcondition\x12 \n" +
+	"\vdisposition\x18\x05 \x01(\tR\vdisposition\x12\x1a\n" +
+	"\blocation\x18\x06 \x01(\tR\blocation\x12+\n" +
+	"\x06refund\x18\a \x01(\v2\x13.supplychain.AmountR\x06refund\"\x88\x02\n" +
+	"\x06Return\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12-\n" +
+	"\x05items\x18\x05 \x03(\v2\x17.supplychain.ReturnItemR\x05items\x126\n" +
+	"\frefund_total\x18\x06 \x01(\v2\x13.supplychain.AmountR\vrefundTotal\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\x03R\tupdatedAt\"\xbc\x01\n" +
+	"\x11CreateItemRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x122\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\v2\x13.supplychain.AmountR\tunitPrice\x12!\n" +
+	"\ftax_category\x18\x05 \x01(\tR\vtaxCategory\";\n" +
+	"\x12CreateItemResponse\x12%\n" +
+	"\x04item\x18\x01 \x01(\v2\x11.supplychain.ItemR\x04item\"\xcc\x01\n" +
+	"\x11UpdateItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x122\n" +
+	"\n" +
+	"unit_price\x18\x05 \x01(\v2\x13.supplychain.AmountR\tunitPrice\x12!\n" +
+	"\ftax_category\x18\x06 \x01(\tR\vtaxCategory\";\n" +
+	"\x12UpdateItemResponse\x12%\n" +
+	"\x04item\x18\x01 \x01(\v2\x11.supplychain.ItemR\x04item\"#\n" +
+	"\x11DeleteItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\".\n" +
+	"\x12DeleteItemResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xe6\x01\n" +
+	"\x12ImportItemsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x122\n" +
+	"\n" +
+	"unit_price\x18\x05 \x01(\v2\x13.supplychain.AmountR\tunitPrice\x12!\n" +
+	"\ftax_category\x18\x06 \x01(\tR\vtaxCategory\x12\x17\n" +
+	"\adry_run\x18\a \x01(\bR\x06dryRun\"K\n" +
+	"\x0fImportItemError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xb4\x01\n" +
+	"\x13ImportItemsResponse\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x05R\aupdated\x12\x1a\n" +
+	"\brejected\x18\x03 \x01(\x05R\brejected\x124\n" +
+	"\x06errors\x18\x04 \x03(\v2\x1c.supplychain.ImportItemErrorR\x06errors\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\"\x8a\x02\n" +
+	"\x12CreateOrderRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12,\n" +
+	"\x05items\x18\x02 \x03(\v2\x16.supplychain.OrderItemR\x05items\x12/\n" +
+	"\x13settlement_currency\x18\x03 \x01(\tR\x12settlementCurrency\x12$\n" +
+	"\x0eship_to_region\x18\x04 \x01(\tR\fshipToRegion\x12!\n" +
+	"\fcoupon_codes\x18\x05 \x03(\tR\vcouponCodes\x12+\n" +
+	"\x12ship_to_address_id\x18\x06 \x01(\tR\x0fshipToAddressId\"?\n" +
+	"\x13CreateOrderResponse\x12(\n" +
+	"\x05order\x18\x01 \x01(\v2\x12.supplychain.OrderR\x05order\"b\n" +
+	"\x13FulfillOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x120\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x1c.supplychain.FulfillmentModeR\x04mode\"\x7f\n" +
+	"\x14FulfillOrderResponse\x12(\n" +
+	"\x05order\x18\x01 \x01(\v2\x12.supplychain.OrderR\x05order\x12=\n" +
+	"\vbackordered\x18\x02 \x03(\v2\x1b.supplychain.StockShortfallR\vbackordered\"]\n" +
+	"\vPickRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"t\n" +
+	"\bPickLine\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1b\n" +
+	"\titem_name\x18\x02 \x01(\tR\bitemName\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06picked\x18\x04 \x01(\x05R\x06picked\"\x88\x02\n" +
+	"\fPickResponse\x12/\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x17.supplychain.PickStatusR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\x12+\n" +
+	"\x05lines\x18\x04 \x03(\v2\x15.supplychain.PickLineR\x05lines\x12(\n" +
+	"\x05order\x18\x05 \x01(\v2\x12.supplychain.OrderR\x05order\x12;\n" +
+	"\n" +
+	"shortfalls\x18\x06 \x03(\v2\x1b.supplychain.StockShortfallR\n" +
+	"shortfalls\"G\n" +
+	"\x12CancelOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"?\n" +
+	"\x13CancelOrderResponse\x12(\n" +
+	"\x05order\x18\x01 \x01(\v2\x12.supplychain.OrderR\x05order\"\x89\x01\n" +
+	"\x15CreateShipmentRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12'\n" +
+	"\x0ftracking_number\x18\x02 \x01(\tR\x0etrackingNumber\x12,\n" +
+	"\x05items\x18\x03 \x03(\v2\x16.supplychain.OrderItemR\x05items\"K\n" +
+	"\x16CreateShipmentResponse\x121\n" +
+	"\bshipment\x18\x01 \x01(\v2\x15.supplychain.ShipmentR\bshipment\"\x98\x01\n" +
+	"\x15UpdateShipmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12'\n" +
+	"\x0ftracking_number\x18\x03 \x01(\tR\x0etrackingNumber\x12\x1a\n" +
+	"\blocation\x18\x04 \x01(\tR\blocation\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"K\n" +
+	"\x16UpdateShipmentResponse\x121\n" +
+	"\bshipment\x18\x01 \x01(\v2\x15.supplychain.ShipmentR\bshipment\"d\n" +
+	"\x10ListItemsRequest\x12\x1f\n" +
+	"\vname_filter\x18\x01 \x01(\tR\n" +
+	"nameFilter\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"R\n" +
+	"\x11ListItemsResponse\x12'\n" +
+	"\x05items\x18\x01 \x03(\v2\x11.supplychain.ItemR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"!\n" +
+	"\x0fGetOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"o\n" +
+	"\x10GetOrderResponse\x12(\n" +
+	"\x05order\x18\x01 \x01(\v2\x12.supplychain.OrderR\x05order\x121\n" +
+	"\ahistory\x18\x02 \x03(\v2\x17.supplychain.OrderEventR\ahistory\"\xc3\x02\n" +
+	"\x11ListOrdersRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rcreated_after\x18\x03 \x01(\x03R\fcreatedAfter\x12%\n" +
+	"\x0ecreated_before\x18\x04 \x01(\x03R\rcreatedBefore\x12\x17\n" +
+	"\aitem_id\x18\x05 \x01(\tR\x06itemId\x124\n" +
+	"\asort_by\x18\x06 \x01(\x0e2\x1b.supplychain.OrderSortFieldR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\a \x01(\bR\n" +
+	"descending\x12\x1b\n" +
+	"\tpage_size\x18\b \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\t \x01(\tR\tpageToken\"h\n" +
+	"\x12ListOrdersResponse\x12*\n" +
+	"\x06orders\x18\x01 \x03(\v2\x12.supplychain.OrderR\x06orders\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"$\n" +
+	"\x12GetShipmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"I\n" +
+	"\x11WatchOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
+	"\x0eafter_event_id\x18\x02 \x01(\x03R\fafterEventId\"L\n" +
+	"\x14WatchShipmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
+	"\x0eafter_event_id\x18\x02 \x01(\x03R\fafterEventId\"|\n" +
+	"\x13GetShipmentResponse\x121\n" +
+	"\bshipment\x18\x01 \x01(\v2\x15.supplychain.ShipmentR\bshipment\x122\n" +
+	"\x06events\x18\x02 \x03(\v2\x1a.supplychain.ShipmentEventR\x06events\"w\n" +
+	"\x13CreateReturnRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12-\n" +
+	"\x05items\x18\x02 \x03(\v2\x17.supplychain.ReturnItemR\x05items\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"C\n" +
+	"\x14CreateReturnResponse\x12+\n" +
+	"\x06return\x18\x01 \x01(\v2\x13.supplychain.ReturnR\x06return\"T\n" +
+	"\x14ApproveReturnRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"D\n" +
+	"\x15ApproveReturnResponse\x12+\n" +
+	"\x06return\x18\x01 \x01(\v2\x13.supplychain.ReturnR\x06return\"s\n" +
+	"\x14ReceiveReturnRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tcondition\x18\x02 \x01(\tR\tcondition\x12-\n" +
+	"\x05items\x18\x03 \x03(\v2\x17.supplychain.ReturnItemR\x05items\"D\n" +
+	"\x15ReceiveReturnResponse\x12+\n" +
+	"\x06return\x18\x01 \x01(\v2\x13.supplychain.ReturnR\x06return\"\"\n" +
+	"\x10GetReturnRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
+	"\x11GetReturnResponse\x12+\n" +
+	"\x06return\x18\x01 \x01(\v2\x13.supplychain.ReturnR\x06return\"b\n" +
+	"\x14ListShipmentsRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"b\n" +
+	"\x15ListShipmentsResponse\x123\n" +
+	"\tshipments\x18\x01 \x03(\v2\x15.supplychain.ShipmentR\tshipments\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"J\n" +
+	"\x17AddExchangeRatesRequest\x12/\n" +
+	"\x05rates\x18\x01 \x03(\v2\x19.supplychain.ExchangeRateR\x05rates\"0\n" +
+	"\x18AddExchangeRatesResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x01(\x05R\x05added\".\n" +
+	"\x1aImportExchangeRatesRequest\x12\x10\n" +
+	"\x03csv\x18\x01 \x01(\fR\x03csv\"9\n" +
+	"\x1bImportExchangeRatesResponse\x12\x1a\n" +
+	"\bimported\x18\x01 \x01(\x05R\bimported\"{\n" +
+	"\x18ListExchangeRatesRequest\x12#\n" +
+	"\rbase_currency\x18\x01 \x01(\tR\fbaseCurrency\x12%\n" +
+	"\x0equote_currency\x18\x02 \x01(\tR\rquoteCurrency\x12\x13\n" +
+	"\x05as_of\x18\x03 \x01(\x03R\x04asOf\"L\n" +
+	"\x19ListExchangeRatesResponse\x12/\n" +
+	"\x05rates\x18\x01 \x03(\v2\x19.supplychain.ExchangeRateR\x05rates\"N\n" +
+	"\x16CreatePromotionRequest\x124\n" +
+	"\tpromotion\x18\x01 \x01(\v2\x16.supplychain.PromotionR\tpromotion\"O\n" +
+	"\x17CreatePromotionResponse\x124\n" +
+	"\tpromotion\x18\x01 \x01(\v2\x16.supplychain.PromotionR\tpromotion\"B\n" +
+	"\x15ListPromotionsRequest\x12)\n" +
+	"\x10include_inactive\x18\x01 \x01(\bR\x0fincludeInactive\"P\n" +
+	"\x16ListPromotionsResponse\x126\n" +
+	"\n" +
+	"promotions\x18\x01 \x03(\v2\x16.supplychain.PromotionR\n" +
+	"promotions\",\n" +
+	"\x1aDeactivatePromotionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"S\n" +
+	"\x1bDeactivatePromotionResponse\x124\n" +
+	"\tpromotion\x18\x01 \x01(\v2\x16.supplychain.PromotionR\tpromotion\"\x9b\x01\n" +
+	"\x15CreateCustomerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x122\n" +
+	"\taddresses\x18\x05 \x03(\v2\x14.supplychain.AddressR\taddresses\"K\n" +
+	"\x16CreateCustomerResponse\x121\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x15.supplychain.CustomerR\bcustomer\"$\n" +
+	"\x12GetCustomerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"H\n" +
+	"\x13GetCustomerResponse\x121\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x15.supplychain.CustomerR\bcustomer\"g\n" +
+	"\x15UpdateCustomerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\"K\n" +
+	"\x16UpdateCustomerResponse\x121\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x15.supplychain.CustomerR\bcustomer\"'\n" +
+	"\x15DeleteCustomerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"2\n" +
+	"\x16DeleteCustomerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"h\n" +
+	"\x14ListCustomersRequest\x12\x1f\n" +
+	"\vname_filter\x18\x01 \x01(\tR\n" +
+	"nameFilter\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"b\n" +
+	"\x15ListCustomersResponse\x123\n" +
+	"\tcustomers\x18\x01 \x03(\v2\x15.supplychain.CustomerR\tcustomers\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"l\n" +
+	"\x19AddCustomerAddressRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12.\n" +
+	"\aaddress\x18\x02 \x01(\v2\x14.supplychain.AddressR\aaddress\"O\n" +
+	"\x1aAddCustomerAddressResponse\x121\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x15.supplychain.CustomerR\bcustomer\"^\n" +
+	"\x1cRemoveCustomerAddressRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\tR\taddressId\"R\n" +
+	"\x1dRemoveCustomerAddressResponse\x121\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x15.supplychain.CustomerR\bcustomer\"\xe4\x01\n" +
+	"\x06ApiKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1f\n" +
+	"\vcustomer_id\x18\x04 \x01(\tR\n" +
+	"customerId\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\x03R\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\x03R\trevokedAt\"\x8b\x01\n" +
+	"\x13CreateApiKeyRequest\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\x03R\texpiresAt\"V\n" +
+	"\x14CreateApiKeyResponse\x12,\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x13.supplychain.ApiKeyR\x06apiKey\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"=\n" +
+	"\x12ListApiKeysRequest\x12'\n" +
+	"\x0finclude_revoked\x18\x01 \x01(\bR\x0eincludeRevoked\"E\n" +
+	"\x13ListApiKeysResponse\x12.\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\x13.supplychain.ApiKeyR\aapiKeys\"%\n" +
+	"\x13RevokeApiKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"D\n" +
+	"\x14RevokeApiKeyResponse\x12,\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x13.supplychain.ApiKeyR\x06apiKey\"J\n" +
+	"\x13RotateApiKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rgrace_seconds\x18\x02 \x01(\x03R\fgraceSeconds\"\x87\x01\n" +
+	"\x14RotateApiKeyResponse\x12,\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x13.supplychain.ApiKeyR\x06apiKey\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12/\n" +
+	"\bprevious\x18\x03 \x01(\v2\x13.supplychain.ApiKeyR\bprevious\"^\n" +
+	"\x04Role\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\":\n" +
+	"\n" +
+	"Permission\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\amethods\x18\x02 \x03(\tR\amethods\"\x12\n" +
+	"\x10ListRolesRequest\"Y\n" +
+	"\x11ListRolesResponse\x12'\n" +
+	"\x05roles\x18\x01 \x03(\v2\x11.supplychain.RoleR\x05roles\x12\x1b\n" +
+	"\tfrom_file\x18\x02 \x01(\bR\bfromFile\"7\n" +
+	"\x0ePutRoleRequest\x12%\n" +
+	"\x04role\x18\x01 \x01(\v2\x11.supplychain.RoleR\x04role\"8\n" +
+	"\x0fPutRoleResponse\x12%\n" +
+	"\x04role\x18\x01 \x01(\v2\x11.supplychain.RoleR\x04role\"'\n" +
+	"\x11DeleteRoleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\".\n" +
+	"\x12DeleteRoleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x18\n" +
+	"\x16ListPermissionsRequest\"T\n" +
+	"\x17ListPermissionsResponse\x129\n" +
+	"\vpermissions\x18\x01 \x03(\v2\x17.supplychain.PermissionR\vpermissions\"\x91\x02\n" +
+	"\x0eInventoryEvent\x123\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1f.supplychain.InventoryEventTypeR\x04type\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12%\n" +
+	"\x0equantity_delta\x18\x05 \x01(\x05R\rquantityDelta\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1f\n" +
+	"\voccurred_at\x18\a \x01(\x03R\n" +
+	"occurredAt\x12!\n" +
+	"\fresume_token\x18\b \x01(\tR\vresumeToken\"v\n" +
+	"\x15WatchInventoryRequest\x12\x19\n" +
+	"\bitem_ids\x18\x01 \x03(\tR\aitemIds\x12\x1f\n" +
+	"\vname_filter\x18\x02 \x01(\tR\n" +
+	"nameFilter\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\"{\n" +
+	"\x17BatchUpdateItemsRequest\x124\n" +
+	"\x05items\x18\x01 \x03(\v2\x1e.supplychain.UpdateItemRequestR\x05items\x12*\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x16.supplychain.BatchModeR\x04mode\"r\n" +
+	"\x0fBatchItemResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12%\n" +
+	"\x04item\x18\x04 \x01(\v2\x11.supplychain.ItemR\x04item\"\x88\x01\n" +
+	"\x18BatchUpdateItemsResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.supplychain.BatchItemResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"U\n" +
+	"\x15BatchGetOrdersRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12*\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x16.supplychain.BatchModeR\x04mode\"\xa9\x01\n" +
+	"\x10BatchOrderResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12(\n" +
+	"\x05order\x18\x04 \x01(\v2\x12.supplychain.OrderR\x05order\x121\n" +
+	"\ahistory\x18\x05 \x03(\v2\x17.supplychain.OrderEventR\ahistory\"\x87\x01\n" +
+	"\x16BatchGetOrdersResponse\x127\n" +
+	"\aresults\x18\x01 \x03(\v2\x1d.supplychain.BatchOrderResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"\x8b\x01\n" +
+	"\x1bBatchUpdateShipmentsRequest\x12@\n" +
+	"\tshipments\x18\x01 \x03(\v2\".supplychain.UpdateShipmentRequestR\tshipments\x12*\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x16.supplychain.BatchModeR\x04mode\"\x82\x01\n" +
+	"\x13BatchShipmentResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x121\n" +
+	"\bshipment\x18\x04 \x01(\v2\x15.supplychain.ShipmentR\bshipment\"\x90\x01\n" +
+	"\x1cBatchUpdateShipmentsResponse\x12:\n" +
+	"\aresults\x18\x01 \x03(\v2 .supplychain.BatchShipmentResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"\\\n" +
+	"\x10AuditLogsRequest\x12\x17\n" +
+	"\aapi_key\x18\x01 \x01(\tR\x06apiKey\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xa4\x01\n" +
+	"\bAuditLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12!\n" +
+	"\frequest_data\x18\x04 \x01(\tR\vrequestData\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1c\n" +
+	"\ttimestamp\x18\x06 \x01(\x03R\ttimestamp\"T\n" +
+	"\x11AuditLogsResponse\x12)\n" +
+	"\x04logs\x18\x01 \x03(\v2\x15.supplychain.AuditLogR\x04logs\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total*B\n" +
+	"\vAddressType\x12\x19\n" +
+	"\x15ADDRESS_TYPE_SHIPPING\x10\x00\x12\x18\n" +
+	"\x14ADDRESS_TYPE_BILLING\x10\x01*\x91\x01\n" +
+	"\rPromotionType\x12\x1d\n" +
+	"\x19PROMOTION_TYPE_PERCENTAGE\x10\x00\x12\x1f\n" +
+	"\x1bPROMOTION_TYPE_FIXED_AMOUNT\x10\x01\x12\x1e\n" +
+	"\x1aPROMOTION_TYPE_BUY_X_GET_Y\x10\x02\x12 \n" +
+	"\x1cPROMOTION_TYPE_TIERED_VOLUME\x10\x03*T\n" +
+	"\x0fFulfillmentMode\x12#\n" +
+	"\x1fFULFILLMENT_MODE_ALL_OR_NOTHING\x10\x00\x12\x1c\n" +
+	"\x18FULFILLMENT_MODE_PARTIAL\x10\x01*\xc1\x01\n" +
+	"\n" +
+	"PickStatus\x12\x17\n" +
+	"\x13PICK_STATUS_STARTED\x10\x00\x12\x16\n" +
+	"\x12PICK_STATUS_PICKED\x10\x01\x12\x1a\n" +
+	"\x16PICK_STATUS_WRONG_ITEM\x10\x02\x12\x19\n" +
+	"\x15PICK_STATUS_OVER_PICK\x10\x03\x12\x19\n" +
+	"\x15PICK_STATUS_COMPLETED\x10\x04\x12\x16\n" +
+	"\x12PICK_STATUS_FAILED\x10\x05\x12\x18\n" +
+	"\x14PICK_STATUS_REJECTED\x10\x06*A\n" +
+	"\x0eOrderSortField\x12\x19\n" +
+	"\x15ORDER_SORT_CREATED_AT\x10\x00\x12\x14\n" +
+	"\x10ORDER_SORT_TOTAL\x10\x01*\xa0\x01\n" +
+	"\x12InventoryEventType\x12 \n" +
+	"\x1cINVENTORY_EVENT_ITEM_CREATED\x10\x00\x12 \n" +
+	"\x1cINVENTORY_EVENT_ITEM_UPDATED\x10\x01\x12 \n" +
+	"\x1cINVENTORY_EVENT_ITEM_DELETED\x10\x02\x12$\n" +
+	" INVENTORY_EVENT_QUANTITY_CHANGED\x10\x03*F\n" +
+	"\tBatchMode\x12\x1d\n" +
+	"\x19BATCH_MODE_ALL_OR_NOTHING\x10\x00\x12\x1a\n" +
+	"\x16BATCH_MODE_BEST_EFFORT\x10\x012\xfd\x1f\n" +
+	"\vSupplyChain\x12M\n" +
+	"\n" +
+	"CreateItem\x12\x1e.supplychain.CreateItemRequest\x1a\x1f.supplychain.CreateItemResponse\x12M\n" +
+	"\n" +
+	"UpdateItem\x12\x1e.supplychain.UpdateItemRequest\x1a\x1f.supplychain.UpdateItemResponse\x12M\n" +
+	"\n" +
+	"DeleteItem\x12\x1e.supplychain.DeleteItemRequest\x1a\x1f.supplychain.DeleteItemResponse\x12J\n" +
+	"\tListItems\x12\x1d.supplychain.ListItemsRequest\x1a\x1e.supplychain.ListItemsResponse\x12S\n" +
+	"\x0eWatchInventory\x12\".supplychain.WatchInventoryRequest\x1a\x1b.supplychain.InventoryEvent0\x01\x12R\n" +
+	"\vImportItems\x12\x1f.supplychain.ImportItemsRequest\x1a .supplychain.ImportItemsResponse(\x01\x12_\n" +
+	"\x10BatchUpdateItems\x12$.supplychain.BatchUpdateItemsRequest\x1a%.supplychain.BatchUpdateItemsResponse\x12P\n" +
+	"\vCreateOrder\x12\x1f.supplychain.CreateOrderRequest\x1a .supplychain.CreateOrderResponse\x12S\n" +
+	"\fFulfillOrder\x12 .supplychain.FulfillOrderRequest\x1a!.supplychain.FulfillOrderResponse\x12G\n" +
+	"\bGetOrder\x12\x1c.supplychain.GetOrderRequest\x1a\x1d.supplychain.GetOrderResponse\x12P\n" +
+	"\vCancelOrder\x12\x1f.supplychain.CancelOrderRequest\x1a .supplychain.CancelOrderResponse\x12M\n" +
+	"\n" +
+	"ListOrders\x12\x1e.supplychain.ListOrdersRequest\x1a\x1f.supplychain.ListOrdersResponse\x12G\n" +
+	"\n" +
+	"WatchOrder\x12\x1e.supplychain.WatchOrderRequest\x1a\x17.supplychain.OrderEvent0\x01\x12Y\n" +
+	"\x0eBatchGetOrders\x12\".supplychain.BatchGetOrdersRequest\x1a#.supplychain.BatchGetOrdersResponse\x12F\n" +
+	"\vPickSession\x12\x18.supplychain.PickRequest\x1a\x19.supplychain.PickResponse(\x010\x01\x12Y\n" +
+	"\x0eCreateShipment\x12\".supplychain.CreateShipmentRequest\x1a#.supplychain.CreateShipmentResponse\x12Y\n" +
+	"\x0eUpdateShipment\x12\".supplychain.UpdateShipmentRequest\x1a#.supplychain.UpdateShipmentResponse\x12P\n" +
+	"\vGetShipment\x12\x1f.supplychain.GetShipmentRequest\x1a .supplychain.GetShipmentResponse\x12V\n" +
+	"\rListShipments\x12!.supplychain.ListShipmentsRequest\x1a\".supplychain.ListShipmentsResponse\x12P\n" +
+	"\rWatchShipment\x12!.supplychain.WatchShipmentRequest\x1a\x1a.supplychain.ShipmentEvent0\x01\x12k\n" +
+	"\x14BatchUpdateShipments\x12(.supplychain.BatchUpdateShipmentsRequest\x1a).supplychain.BatchUpdateShipmentsResponse\x12S\n" +
+	"\fCreateReturn\x12 .supplychain.CreateReturnRequest\x1a!.supplychain.CreateReturnResponse\x12V\n" +
+	"\rApproveReturn\x12!.supplychain.ApproveReturnRequest\x1a\".supplychain.ApproveReturnResponse\x12V\n" +
+	"\rReceiveReturn\x12!.supplychain.ReceiveReturnRequest\x1a\".supplychain.ReceiveReturnResponse\x12J\n" +
+	"\tGetReturn\x12\x1d.supplychain.GetReturnRequest\x1a\x1e.supplychain.GetReturnResponse\x12Y\n" +
+	"\x0eCreateCustomer\x12\".supplychain.CreateCustomerRequest\x1a#.supplychain.CreateCustomerResponse\x12P\n" +
+	"\vGetCustomer\x12\x1f.supplychain.GetCustomerRequest\x1a .supplychain.GetCustomerResponse\x12Y\n" +
+	"\x0eUpdateCustomer\x12\".supplychain.UpdateCustomerRequest\x1a#.supplychain.UpdateCustomerResponse\x12Y\n" +
+	"\x0eDeleteCustomer\x12\".supplychain.DeleteCustomerRequest\x1a#.supplychain.DeleteCustomerResponse\x12V\n" +
+	"\rListCustomers\x12!.supplychain.ListCustomersRequest\x1a\".supplychain.ListCustomersResponse\x12e\n" +
+	"\x12AddCustomerAddress\x12&.supplychain.AddCustomerAddressRequest\x1a'.supplychain.AddCustomerAddressResponse\x12n\n" +
+	"\x15RemoveCustomerAddress\x12).supplychain.RemoveCustomerAddressRequest\x1a*.supplychain.RemoveCustomerAddressResponse\x12_\n" +
+	"\x10AddExchangeRates\x12$.supplychain.AddExchangeRatesRequest\x1a%.supplychain.AddExchangeRatesResponse\x12h\n" +
+	"\x13ImportExchangeRates\x12'.supplychain.ImportExchangeRatesRequest\x1a(.supplychain.ImportExchangeRatesResponse\x12b\n" +
+	"\x11ListExchangeRates\x12%.supplychain.ListExchangeRatesRequest\x1a&.supplychain.ListExchangeRatesResponse\x12\\\n" +
+	"\x0fCreatePromotion\x12#.supplychain.CreatePromotionRequest\x1a$.supplychain.CreatePromotionResponse\x12Y\n" +
+	"\x0eListPromotions\x12\".supplychain.ListPromotionsRequest\x1a#.supplychain.ListPromotionsResponse\x12h\n" +
+	"\x13DeactivatePromotion\x12'.supplychain.DeactivatePromotionRequest\x1a(.supplychain.DeactivatePromotionResponse\x12J\n" +
+	"\tAuditLogs\x12\x1d.supplychain.AuditLogsRequest\x1a\x1e.supplychain.AuditLogsResponse\x12S\n" +
+	"\fCreateApiKey\x12 .supplychain.CreateApiKeyRequest\x1a!.supplychain.CreateApiKeyResponse\x12P\n" +
+	"\vListApiKeys\x12\x1f.supplychain.ListApiKeysRequest\x1a .supplychain.ListApiKeysResponse\x12S\n" +
+	"\fRevokeApiKey\x12 .supplychain.RevokeApiKeyRequest\x1a!.supplychain.RevokeApiKeyResponse\x12S\n" +
+	"\fRotateApiKey\x12 .supplychain.RotateApiKeyRequest\x1a!.supplychain.RotateApiKeyResponse\x12J\n" +
+	"\tListRoles\x12\x1d.supplychain.ListRolesRequest\x1a\x1e.supplychain.ListRolesResponse\x12D\n" +
+	"\aPutRole\x12\x1b.supplychain.PutRoleRequest\x1a\x1c.supplychain.PutRoleResponse\x12M\n" +
+	"\n" +
+	"DeleteRole\x12\x1e.supplychain.DeleteRoleRequest\x1a\x1f.supplychain.DeleteRoleResponse\x12\\\n" +
+	"\x0fListPermissions\x12#.supplychain.ListPermissionsRequest\x1a$.supplychain.ListPermissionsResponseB-Z+github.com/Scrimzay/supplychain/supplychainb\x06proto3"
 
 var (
 	file_supplychain_supplychain_proto_rawDescOnce sync.Once
@@ -1845,81 +8197,331 @@ func file_supplychain_supplychain_proto_rawDescGZIP() []byte {
 	return file_supplychain_supplychain_proto_rawDescData
 }
 
-var file_supplychain_supplychain_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_supplychain_supplychain_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_supplychain_supplychain_proto_msgTypes = make([]protoimpl.MessageInfo, 118)
 var file_supplychain_supplychain_proto_goTypes = []any{
-	(*Amount)(nil),                 // 0: supplychain.Amount
-	(*Item)(nil),                   // 1: supplychain.Item
-	(*Order)(nil),                  // 2: supplychain.Order
-	(*OrderItem)(nil),              // 3: supplychain.OrderItem
-	(*Shipment)(nil),               // 4: supplychain.Shipment
-	(*CreateItemRequest)(nil),      // 5: supplychain.CreateItemRequest
-	(*CreateItemResponse)(nil),     // 6: supplychain.CreateItemResponse
-	(*UpdateItemRequest)(nil),      // 7: supplychain.UpdateItemRequest
-	(*UpdateItemResponse)(nil),     // 8: supplychain.UpdateItemResponse
-	(*DeleteItemRequest)(nil),      // 9: supplychain.DeleteItemRequest
-	(*DeleteItemResponse)(nil),     // 10: supplychain.DeleteItemResponse
-	(*CreateOrderRequest)(nil),     // 11: supplychain.CreateOrderRequest
-	(*CreateOrderResponse)(nil),    // 12: supplychain.CreateOrderResponse
-	(*FulfillOrderRequest)(nil),    // 13: supplychain.FulfillOrderRequest
-	(*FulfillOrderResponse)(nil),   // 14: supplychain.FulfillOrderResponse
-	(*CreateShipmentRequest)(nil),  // 15: supplychain.CreateShipmentRequest
-	(*CreateShipmentResponse)(nil), // 16: supplychain.CreateShipmentResponse
-	(*UpdateShipmentRequest)(nil),  // 17: supplychain.UpdateShipmentRequest
-	(*UpdateShipmentResponse)(nil), // 18: supplychain.UpdateShipmentResponse
-	(*ListItemsRequest)(nil),       // 19: supplychain.ListItemsRequest
-	(*ListItemsResponse)(nil),      // 20: supplychain.ListItemsResponse
-	(*GetOrderRequest)(nil),        // 21: supplychain.GetOrderRequest
-	(*GetOrderResponse)(nil),       // 22: supplychain.GetOrderResponse
-	(*ListShipmentsRequest)(nil),   // 23: supplychain.ListShipmentsRequest
-	(*ListShipmentsResponse)(nil),  // 24: supplychain.ListShipmentsResponse
-	(*AuditLogsRequest)(nil),       // 25: supplychain.AuditLogsRequest
-	(*AuditLog)(nil),               // 26: supplychain.AuditLog
-	(*AuditLogsResponse)(nil),      // 27: supplychain.AuditLogsResponse
+	(AddressType)(0),                      // 0: supplychain.AddressType
+	(PromotionType)(0),                    // 1: supplychain.PromotionType
+	(FulfillmentMode)(0),                  // 2: supplychain.FulfillmentMode
+	(PickStatus)(0),                       // 3: supplychain.PickStatus
+	(OrderSortField)(0),                   // 4: supplychain.OrderSortField
+	(InventoryEventType)(0),               // 5: supplychain.InventoryEventType
+	(BatchMode)(0),                        // 6: supplychain.BatchMode
+	(*Amount)(nil),                        // 7: supplychain.Amount
+	(*Item)(nil),                          // 8: supplychain.Item
+	(*Order)(nil),                         // 9: supplychain.Order
+	(*Address)(nil),                       // 10: supplychain.Address
+	(*Customer)(nil),                      // 11: supplychain.Customer
+	(*OrderItem)(nil),                     // 12: supplychain.OrderItem
+	(*LineDiscount)(nil),                  // 13: supplychain.LineDiscount
+	(*DiscountTier)(nil),                  // 14: supplychain.DiscountTier
+	(*Promotion)(nil),                     // 15: supplychain.Promotion
+	(*ExchangeRate)(nil),                  // 16: supplychain.ExchangeRate
+	(*OrderEvent)(nil),                    // 17: supplychain.OrderEvent
+	(*Shipment)(nil),                      // 18: supplychain.Shipment
+	(*ShipmentEvent)(nil),                 // 19: supplychain.ShipmentEvent
+	(*StockShortfall)(nil),                // 20: supplychain.StockShortfall
+	(*InsufficientStock)(nil),             // 21: supplychain.InsufficientStock
+	(*ReturnItem)(nil),                    // 22: supplychain.ReturnItem
+	(*Return)(nil),                        // 23: supplychain.Return
+	(*CreateItemRequest)(nil),             // 24: supplychain.CreateItemRequest
+	(*CreateItemResponse)(nil),            // 25: supplychain.CreateItemResponse
+	(*UpdateItemRequest)(nil),             // 26: supplychain.UpdateItemRequest
+	(*UpdateItemResponse)(nil),            // 27: supplychain.UpdateItemResponse
+	(*DeleteItemRequest)(nil),             // 28: supplychain.DeleteItemRequest
+	(*DeleteItemResponse)(nil),            // 29: supplychain.DeleteItemResponse
+	(*ImportItemsRequest)(nil),            // 30: supplychain.ImportItemsRequest
+	(*ImportItemError)(nil),               // 31: supplychain.ImportItemError
+	(*ImportItemsResponse)(nil),           // 32: supplychain.ImportItemsResponse
+	(*CreateOrderRequest)(nil),            // 33: supplychain.CreateOrderRequest
+	(*CreateOrderResponse)(nil),           // 34: supplychain.CreateOrderResponse
+	(*FulfillOrderRequest)(nil),           // 35: supplychain.FulfillOrderRequest
+	(*FulfillOrderResponse)(nil),          // 36: supplychain.FulfillOrderResponse
+	(*PickRequest)(nil),                   // 37: supplychain.PickRequest
+	(*PickLine)(nil),                      // 38: supplychain.PickLine
+	(*PickResponse)(nil),                  // 39: supplychain.PickResponse
+	(*CancelOrderRequest)(nil),            // 40: supplychain.CancelOrderRequest
+	(*CancelOrderResponse)(nil),           // 41: supplychain.CancelOrderResponse
+	(*CreateShipmentRequest)(nil),         // 42: supplychain.CreateShipmentRequest
+	(*CreateShipmentResponse)(nil),        // 43: supplychain.CreateShipmentResponse
+	(*UpdateShipmentRequest)(nil),         // 44: supplychain.UpdateShipmentRequest
+	(*UpdateShipmentResponse)(nil),        // 45: supplychain.UpdateShipmentResponse
+	(*ListItemsRequest)(nil),              // 46: supplychain.ListItemsRequest
+	(*ListItemsResponse)(nil),             // 47: supplychain.ListItemsResponse
+	(*GetOrderRequest)(nil),               // 48: supplychain.GetOrderRequest
+	(*GetOrderResponse)(nil),              // 49: supplychain.GetOrderResponse
+	(*ListOrdersRequest)(nil),             // 50: supplychain.ListOrdersRequest
+	(*ListOrdersResponse)(nil),            // 51: supplychain.ListOrdersResponse
+	(*GetShipmentRequest)(nil),            // 52: supplychain.GetShipmentRequest
+	(*WatchOrderRequest)(nil),             // 53: supplychain.WatchOrderRequest
+	(*WatchShipmentRequest)(nil),          // 54: supplychain.WatchShipmentRequest
+	(*GetShipmentResponse)(nil),           // 55: supplychain.GetShipmentResponse
+	(*CreateReturnRequest)(nil),           // 56: supplychain.CreateReturnRequest
+	(*CreateReturnResponse)(nil),          // 57: supplychain.CreateReturnResponse
+	(*ApproveReturnRequest)(nil),          // 58: supplychain.ApproveReturnRequest
+	(*ApproveReturnResponse)(nil),         // 59: supplychain.ApproveReturnResponse
+	(*ReceiveReturnRequest)(nil),          // 60: supplychain.ReceiveReturnRequest
+	(*ReceiveReturnResponse)(nil),         // 61: supplychain.ReceiveReturnResponse
+	(*GetReturnRequest)(nil),              // 62: supplychain.GetReturnRequest
+	(*GetReturnResponse)(nil),             // 63: supplychain.GetReturnResponse
+	(*ListShipmentsRequest)(nil),          // 64: supplychain.ListShipmentsRequest
+	(*ListShipmentsResponse)(nil),         // 65: supplychain.ListShipmentsResponse
+	(*AddExchangeRatesRequest)(nil),       // 66: supplychain.AddExchangeRatesRequest
+	(*AddExchangeRatesResponse)(nil),      // 67: supplychain.AddExchangeRatesResponse
+	(*ImportExchangeRatesRequest)(nil),    // 68: supplychain.ImportExchangeRatesRequest
+	(*ImportExchangeRatesResponse)(nil),   // 69: supplychain.ImportExchangeRatesResponse
+	(*ListExchangeRatesRequest)(nil),      // 70: supplychain.ListExchangeRatesRequest
+	(*ListExchangeRatesResponse)(nil),     // 71: supplychain.ListExchangeRatesResponse
+	(*CreatePromotionRequest)(nil),        // 72: supplychain.CreatePromotionRequest
+	(*CreatePromotionResponse)(nil),       // 73: supplychain.CreatePromotionResponse
+	(*ListPromotionsRequest)(nil),         // 74: supplychain.ListPromotionsRequest
+	(*ListPromotionsResponse)(nil),        // 75: supplychain.ListPromotionsResponse
+	(*DeactivatePromotionRequest)(nil),    // 76: supplychain.DeactivatePromotionRequest
+	(*DeactivatePromotionResponse)(nil),   // 77: supplychain.DeactivatePromotionResponse
+	(*CreateCustomerRequest)(nil),         // 78: supplychain.CreateCustomerRequest
+	(*CreateCustomerResponse)(nil),        // 79: supplychain.CreateCustomerResponse
+	(*GetCustomerRequest)(nil),            // 80: supplychain.GetCustomerRequest
+	(*GetCustomerResponse)(nil),           // 81: supplychain.GetCustomerResponse
+	(*UpdateCustomerRequest)(nil),         // 82: supplychain.UpdateCustomerRequest
+	(*UpdateCustomerResponse)(nil),        // 83: supplychain.UpdateCustomerResponse
+	(*DeleteCustomerRequest)(nil),         // 84: supplychain.DeleteCustomerRequest
+	(*DeleteCustomerResponse)(nil),        // 85: supplychain.DeleteCustomerResponse
+	(*ListCustomersRequest)(nil),          // 86: supplychain.ListCustomersRequest
+	(*ListCustomersResponse)(nil),         // 87: supplychain.ListCustomersResponse
+	(*AddCustomerAddressRequest)(nil),     // 88: supplychain.AddCustomerAddressRequest
+	(*AddCustomerAddressResponse)(nil),    // 89: supplychain.AddCustomerAddressResponse
+	(*RemoveCustomerAddressRequest)(nil),  // 90: supplychain.RemoveCustomerAddressRequest
+	(*RemoveCustomerAddressResponse)(nil), // 91: supplychain.RemoveCustomerAddressResponse
+	(*ApiKey)(nil),                        // 92: supplychain.ApiKey
+	(*CreateApiKeyRequest)(nil),           // 93: supplychain.CreateApiKeyRequest
+	(*CreateApiKeyResponse)(nil),          // 94: supplychain.CreateApiKeyResponse
+	(*ListApiKeysRequest)(nil),            // 95: supplychain.ListApiKeysRequest
+	(*ListApiKeysResponse)(nil),           // 96: supplychain.ListApiKeysResponse
+	(*RevokeApiKeyRequest)(nil),           // 97: supplychain.RevokeApiKeyRequest
+	(*RevokeApiKeyResponse)(nil),          // 98: supplychain.RevokeApiKeyResponse
+	(*RotateApiKeyRequest)(nil),           // 99: supplychain.RotateApiKeyRequest
+	(*RotateApiKeyResponse)(nil),          // 100: supplychain.RotateApiKeyResponse
+	(*Role)(nil),                          // 101: supplychain.Role
+	(*Permission)(nil),                    // 102: supplychain.Permission
+	(*ListRolesRequest)(nil),              // 103: supplychain.ListRolesRequest
+	(*ListRolesResponse)(nil),             // 104: supplychain.ListRolesResponse
+	(*PutRoleRequest)(nil),                // 105: supplychain.PutRoleRequest
+	(*PutRoleResponse)(nil),               // 106: supplychain.PutRoleResponse
+	(*DeleteRoleRequest)(nil),             // 107: supplychain.DeleteRoleRequest
+	(*DeleteRoleResponse)(nil),            // 108: supplychain.DeleteRoleResponse
+	(*ListPermissionsRequest)(nil),        // 109: supplychain.ListPermissionsRequest
+	(*ListPermissionsResponse)(nil),       // 110: supplychain.ListPermissionsResponse
+	(*InventoryEvent)(nil),                // 111: supplychain.InventoryEvent
+	(*WatchInventoryRequest)(nil),         // 112: supplychain.WatchInventoryRequest
+	(*BatchUpdateItemsRequest)(nil),       // 113: supplychain.BatchUpdateItemsRequest
+	(*BatchItemResult)(nil),               // 114: supplychain.BatchItemResult
+	(*BatchUpdateItemsResponse)(nil),      // 115: supplychain.BatchUpdateItemsResponse
+	(*BatchGetOrdersRequest)(nil),         // 116: supplychain.BatchGetOrdersRequest
+	(*BatchOrderResult)(nil),              // 117: supplychain.BatchOrderResult
+	(*BatchGetOrdersResponse)(nil),        // 118: supplychain.BatchGetOrdersResponse
+	(*BatchUpdateShipmentsRequest)(nil),   // 119: supplychain.BatchUpdateShipmentsRequest
+	(*BatchShipmentResult)(nil),           // 120: supplychain.BatchShipmentResult
+	(*BatchUpdateShipmentsResponse)(nil),  // 121: supplychain.BatchUpdateShipmentsResponse
+	(*AuditLogsRequest)(nil),              // 122: supplychain.AuditLogsRequest
+	(*AuditLog)(nil),                      // 123: supplychain.AuditLog
+	(*AuditLogsResponse)(nil),             // 124: supplychain.AuditLogsResponse
 }
 var file_supplychain_supplychain_proto_depIdxs = []int32{
-	0,  // 0: supplychain.Item.unit_price:type_name -> supplychain.Amount
-	3,  // 1: supplychain.Order.items:type_name -> supplychain.OrderItem
-	0,  // 2: supplychain.Order.total:type_name -> supplychain.Amount
-	0,  // 3: supplychain.CreateItemRequest.unit_price:type_name -> supplychain.Amount
-	1,  // 4: supplychain.CreateItemResponse.item:type_name -> supplychain.Item
-	0,  // 5: supplychain.UpdateItemRequest.unit_price:type_name -> supplychain.Amount
-	1,  // 6: supplychain.UpdateItemResponse.item:type_name -> supplychain.Item
-	3,  // 7: supplychain.CreateOrderRequest.items:type_name -> supplychain.OrderItem
-	2,  // 8: supplychain.CreateOrderResponse.order:type_name -> supplychain.Order
-	2,  // 9: supplychain.FulfillOrderResponse.order:type_name -> supplychain.Order
-	4,  // 10: supplychain.CreateShipmentResponse.shipment:type_name -> supplychain.Shipment
-	4,  // 11: supplychain.UpdateShipmentResponse.shipment:type_name -> supplychain.Shipment
-	1,  // 12: supplychain.ListItemsResponse.items:type_name -> supplychain.Item
-	2,  // 13: supplychain.GetOrderResponse.order:type_name -> supplychain.Order
-	4,  // 14: supplychain.ListShipmentsResponse.shipments:type_name -> supplychain.Shipment
-	26, // 15: supplychain.AuditLogsResponse.logs:type_name -> supplychain.AuditLog
-	5,  // 16: supplychain.SupplyChain.CreateItem:input_type -> supplychain.CreateItemRequest
-	7,  // 17: supplychain.SupplyChain.UpdateItem:input_type -> supplychain.UpdateItemRequest
-	9,  // 18: supplychain.SupplyChain.DeleteItem:input_type -> supplychain.DeleteItemRequest
-	19, // 19: supplychain.SupplyChain.ListItems:input_type -> supplychain.ListItemsRequest
-	11, // 20: supplychain.SupplyChain.CreateOrder:input_type -> supplychain.CreateOrderRequest
-	13, // 21: supplychain.SupplyChain.FulfillOrder:input_type -> supplychain.FulfillOrderRequest
-	21, // 22: supplychain.SupplyChain.GetOrder:input_type -> supplychain.GetOrderRequest
-	15, // 23: supplychain.SupplyChain.CreateShipment:input_type -> supplychain.CreateShipmentRequest
-	17, // 24: supplychain.SupplyChain.UpdateShipment:input_type -> supplychain.UpdateShipmentRequest
-	23, // 25: supplychain.SupplyChain.ListShipments:input_type -> supplychain.ListShipmentsRequest
-	25, // 26: supplychain.SupplyChain.AuditLogs:input_type -> supplychain.AuditLogsRequest
-	6,  // 27: supplychain.SupplyChain.CreateItem:output_type -> supplychain.CreateItemResponse
-	8,  // 28: supplychain.SupplyChain.UpdateItem:output_type -> supplychain.UpdateItemResponse
-	10, // 29: supplychain.SupplyChain.DeleteItem:output_type -> supplychain.DeleteItemResponse
-	20, // 30: supplychain.SupplyChain.ListItems:output_type -> supplychain.ListItemsResponse
-	12, // 31: supplychain.SupplyChain.CreateOrder:output_type -> supplychain.CreateOrderResponse
-	14, // 32: supplychain.SupplyChain.FulfillOrder:output_type -> supplychain.FulfillOrderResponse
-	22, // 33: supplychain.SupplyChain.GetOrder:output_type -> supplychain.GetOrderResponse
-	16, // 34: supplychain.SupplyChain.CreateShipment:output_type -> supplychain.CreateShipmentResponse
-	18, // 35: supplychain.SupplyChain.UpdateShipment:output_type -> supplychain.UpdateShipmentResponse
-	24, // 36: supplychain.SupplyChain.ListShipments:output_type -> supplychain.ListShipmentsResponse
-	27, // 37: supplychain.SupplyChain.AuditLogs:output_type -> supplychain.AuditLogsResponse
-	27, // [27:38] is the sub-list for method output_type
-	16, // [16:27] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	7,   // 0: supplychain.Item.unit_price:type_name -> supplychain.Amount
+	12,  // 1: supplychain.Order.items:type_name -> supplychain.OrderItem
+	7,   // 2: supplychain.Order.total:type_name -> supplychain.Amount
+	7,   // 3: supplychain.Order.subtotal:type_name -> supplychain.Amount
+	7,   // 4: supplychain.Order.tax_total:type_name -> supplychain.Amount
+	7,   // 5: supplychain.Order.discount_total:type_name -> supplychain.Amount
+	10,  // 6: supplychain.Order.ship_to:type_name -> supplychain.Address
+	0,   // 7: supplychain.Address.type:type_name -> supplychain.AddressType
+	10,  // 8: supplychain.Customer.addresses:type_name -> supplychain.Address
+	7,   // 9: supplychain.OrderItem.unit_price:type_name -> supplychain.Amount
+	7,   // 10: supplychain.OrderItem.line_total:type_name -> supplychain.Amount
+	7,   // 11: supplychain.OrderItem.original_unit_price:type_name -> supplychain.Amount
+	7,   // 12: supplychain.OrderItem.original_line_total:type_name -> supplychain.Amount
+	7,   // 13: supplychain.OrderItem.tax:type_name -> supplychain.Amount
+	13,  // 14: supplychain.OrderItem.discounts:type_name -> supplychain.LineDiscount
+	7,   // 15: supplychain.OrderItem.discount:type_name -> supplychain.Amount
+	7,   // 16: supplychain.LineDiscount.amount:type_name -> supplychain.Amount
+	1,   // 17: supplychain.Promotion.type:type_name -> supplychain.PromotionType
+	7,   // 18: supplychain.Promotion.amount_off:type_name -> supplychain.Amount
+	14,  // 19: supplychain.Promotion.tiers:type_name -> supplychain.DiscountTier
+	12,  // 20: supplychain.Shipment.items:type_name -> supplychain.OrderItem
+	10,  // 21: supplychain.Shipment.ship_to:type_name -> supplychain.Address
+	20,  // 22: supplychain.InsufficientStock.lines:type_name -> supplychain.StockShortfall
+	7,   // 23: supplychain.ReturnItem.refund:type_name -> supplychain.Amount
+	22,  // 24: supplychain.Return.items:type_name -> supplychain.ReturnItem
+	7,   // 25: supplychain.Return.refund_total:type_name -> supplychain.Amount
+	7,   // 26: supplychain.CreateItemRequest.unit_price:type_name -> supplychain.Amount
+	8,   // 27: supplychain.CreateItemResponse.item:type_name -> supplychain.Item
+	7,   // 28: supplychain.UpdateItemRequest.unit_price:type_name -> supplychain.Amount
+	8,   // 29: supplychain.UpdateItemResponse.item:type_name -> supplychain.Item
+	7,   // 30: supplychain.ImportItemsRequest.unit_price:type_name -> supplychain.Amount
+	31,  // 31: supplychain.ImportItemsResponse.errors:type_name -> supplychain.ImportItemError
+	12,  // 32: supplychain.CreateOrderRequest.items:type_name -> supplychain.OrderItem
+	9,   // 33: supplychain.CreateOrderResponse.order:type_name -> supplychain.Order
+	2,   // 34: supplychain.FulfillOrderRequest.mode:type_name -> supplychain.FulfillmentMode
+	9,   // 35: supplychain.FulfillOrderResponse.order:type_name -> supplychain.Order
+	20,  // 36: supplychain.FulfillOrderResponse.backordered:type_name -> supplychain.StockShortfall
+	3,   // 37: supplychain.PickResponse.status:type_name -> supplychain.PickStatus
+	38,  // 38: supplychain.PickResponse.lines:type_name -> supplychain.PickLine
+	9,   // 39: supplychain.PickResponse.order:type_name -> supplychain.Order
+	20,  // 40: supplychain.PickResponse.shortfalls:type_name -> supplychain.StockShortfall
+	9,   // 41: supplychain.CancelOrderResponse.order:type_name -> supplychain.Order
+	12,  // 42: supplychain.CreateShipmentRequest.items:type_name -> supplychain.OrderItem
+	18,  // 43: supplychain.CreateShipmentResponse.shipment:type_name -> supplychain.Shipment
+	18,  // 44: supplychain.UpdateShipmentResponse.shipment:type_name -> supplychain.Shipment
+	8,   // 45: supplychain.ListItemsResponse.items:type_name -> supplychain.Item
+	9,   // 46: supplychain.GetOrderResponse.order:type_name -> supplychain.Order
+	17,  // 47: supplychain.GetOrderResponse.history:type_name -> supplychain.OrderEvent
+	4,   // 48: supplychain.ListOrdersRequest.sort_by:type_name -> supplychain.OrderSortField
+	9,   // 49: supplychain.ListOrdersResponse.orders:type_name -> supplychain.Order
+	18,  // 50: supplychain.GetShipmentResponse.shipment:type_name -> supplychain.Shipment
+	19,  // 51: supplychain.GetShipmentResponse.events:type_name -> supplychain.ShipmentEvent
+	22,  // 52: supplychain.CreateReturnRequest.items:type_name -> supplychain.ReturnItem
+	23,  // 53: supplychain.CreateReturnResponse.return:type_name -> supplychain.Return
+	23,  // 54: supplychain.ApproveReturnResponse.return:type_name -> supplychain.Return
+	22,  // 55: supplychain.ReceiveReturnRequest.items:type_name -> supplychain.ReturnItem
+	23,  // 56: supplychain.ReceiveReturnResponse.return:type_name -> supplychain.Return
+	23,  // 57: supplychain.GetReturnResponse.return:type_name -> supplychain.Return
+	18,  // 58: supplychain.ListShipmentsResponse.shipments:type_name -> supplychain.Shipment
+	16,  // 59: supplychain.AddExchangeRatesRequest.rates:type_name -> supplychain.ExchangeRate
+	16,  // 60: supplychain.ListExchangeRatesResponse.rates:type_name -> supplychain.ExchangeRate
+	15,  // 61: supplychain.CreatePromotionRequest.promotion:type_name -> supplychain.Promotion
+	15,  // 62: supplychain.CreatePromotionResponse.promotion:type_name -> supplychain.Promotion
+	15,  // 63: supplychain.ListPromotionsResponse.promotions:type_name -> supplychain.Promotion
+	15,  // 64: supplychain.DeactivatePromotionResponse.promotion:type_name -> supplychain.Promotion
+	10,  // 65: supplychain.CreateCustomerRequest.addresses:type_name -> supplychain.Address
+	11,  // 66: supplychain.CreateCustomerResponse.customer:type_name -> supplychain.Customer
+	11,  // 67: supplychain.GetCustomerResponse.customer:type_name -> supplychain.Customer
+	11,  // 68: supplychain.UpdateCustomerResponse.customer:type_name -> supplychain.Customer
+	11,  // 69: supplychain.ListCustomersResponse.customers:type_name -> supplychain.Customer
+	10,  // 70: supplychain.AddCustomerAddressRequest.address:type_name -> supplychain.Address
+	11,  // 71: supplychain.AddCustomerAddressResponse.customer:type_name -> supplychain.Customer
+	11,  // 72: supplychain.RemoveCustomerAddressResponse.customer:type_name -> supplychain.Customer
+	92,  // 73: supplychain.CreateApiKeyResponse.api_key:type_name -> supplychain.ApiKey
+	92,  // 74: supplychain.ListApiKeysResponse.api_keys:type_name -> supplychain.ApiKey
+	92,  // 75: supplychain.RevokeApiKeyResponse.api_key:type_name -> supplychain.ApiKey
+	92,  // 76: supplychain.RotateApiKeyResponse.api_key:type_name -> supplychain.ApiKey
+	92,  // 77: supplychain.RotateApiKeyResponse.previous:type_name -> supplychain.ApiKey
+	101, // 78: supplychain.ListRolesResponse.roles:type_name -> supplychain.Role
+	101, // 79: supplychain.PutRoleRequest.role:type_name -> supplychain.Role
+	101, // 80: supplychain.PutRoleResponse.role:type_name -> supplychain.Role
+	102, // 81: supplychain.ListPermissionsResponse.permissions:type_name -> supplychain.Permission
+	5,   // 82: supplychain.InventoryEvent.type:type_name -> supplychain.InventoryEventType
+	26,  // 83: supplychain.BatchUpdateItemsRequest.items:type_name -> supplychain.UpdateItemRequest
+	6,   // 84: supplychain.BatchUpdateItemsRequest.mode:type_name -> supplychain.BatchMode
+	8,   // 85: supplychain.BatchItemResult.item:type_name -> supplychain.Item
+	114, // 86: supplychain.BatchUpdateItemsResponse.results:type_name -> supplychain.BatchItemResult
+	6,   // 87: supplychain.BatchGetOrdersRequest.mode:type_name -> supplychain.BatchMode
+	9,   // 88: supplychain.BatchOrderResult.order:type_name -> supplychain.Order
+	17,  // 89: supplychain.BatchOrderResult.history:type_name -> supplychain.OrderEvent
+	117, // 90: supplychain.BatchGetOrdersResponse.results:type_name -> supplychain.BatchOrderResult
+	44,  // 91: supplychain.BatchUpdateShipmentsRequest.shipments:type_name -> supplychain.UpdateShipmentRequest
+	6,   // 92: supplychain.BatchUpdateShipmentsRequest.mode:type_name -> supplychain.BatchMode
+	18,  // 93: supplychain.BatchShipmentResult.shipment:type_name -> supplychain.Shipment
+	120, // 94: supplychain.BatchUpdateShipmentsResponse.results:type_name -> supplychain.BatchShipmentResult
+	123, // 95: supplychain.AuditLogsResponse.logs:type_name -> supplychain.AuditLog
+	24,  // 96: supplychain.SupplyChain.CreateItem:input_type -> supplychain.CreateItemRequest
+	26,  // 97: supplychain.SupplyChain.UpdateItem:input_type -> supplychain.UpdateItemRequest
+	28,  // 98: supplychain.SupplyChain.DeleteItem:input_type -> supplychain.DeleteItemRequest
+	46,  // 99: supplychain.SupplyChain.ListItems:input_type -> supplychain.ListItemsRequest
+	112, // 100: supplychain.SupplyChain.WatchInventory:input_type -> supplychain.WatchInventoryRequest
+	30,  // 101: supplychain.SupplyChain.ImportItems:input_type -> supplychain.ImportItemsRequest
+	113, // 102: supplychain.SupplyChain.BatchUpdateItems:input_type -> supplychain.BatchUpdateItemsRequest
+	33,  // 103: supplychain.SupplyChain.CreateOrder:input_type -> supplychain.CreateOrderRequest
+	35,  // 104: supplychain.SupplyChain.FulfillOrder:input_type -> supplychain.FulfillOrderRequest
+	48,  // 105: supplychain.SupplyChain.GetOrder:input_type -> supplychain.GetOrderRequest
+	40,  // 106: supplychain.SupplyChain.CancelOrder:input_type -> supplychain.CancelOrderRequest
+	50,  // 107: supplychain.SupplyChain.ListOrders:input_type -> supplychain.ListOrdersRequest
+	53,  // 108: supplychain.SupplyChain.WatchOrder:input_type -> supplychain.WatchOrderRequest
+	116, // 109: supplychain.SupplyChain.BatchGetOrders:input_type -> supplychain.BatchGetOrdersRequest
+	37,  // 110: supplychain.SupplyChain.PickSession:input_type -> supplychain.PickRequest
+	42,  // 111: supplychain.SupplyChain.CreateShipment:input_type -> supplychain.CreateShipmentRequest
+	44,  // 112: supplychain.SupplyChain.UpdateShipment:input_type -> supplychain.UpdateShipmentRequest
+	52,  // 113: supplychain.SupplyChain.GetShipment:input_type -> supplychain.GetShipmentRequest
+	64,  // 114: supplychain.SupplyChain.ListShipments:input_type -> supplychain.ListShipmentsRequest
+	54,  // 115: supplychain.SupplyChain.WatchShipment:input_type -> supplychain.WatchShipmentRequest
+	119, // 116: supplychain.SupplyChain.BatchUpdateShipments:input_type -> supplychain.BatchUpdateShipmentsRequest
+	56,  // 117: supplychain.SupplyChain.CreateReturn:input_type -> supplychain.CreateReturnRequest
+	58,  // 118: supplychain.SupplyChain.ApproveReturn:input_type -> supplychain.ApproveReturnRequest
+	60,  // 119: supplychain.SupplyChain.ReceiveReturn:input_type -> supplychain.ReceiveReturnRequest
+	62,  // 120: supplychain.SupplyChain.GetReturn:input_type -> supplychain.GetReturnRequest
+	78,  // 121: supplychain.SupplyChain.CreateCustomer:input_type -> supplychain.CreateCustomerRequest
+	80,  // 122: supplychain.SupplyChain.GetCustomer:input_type -> supplychain.GetCustomerRequest
+	82,  // 123: supplychain.SupplyChain.UpdateCustomer:input_type -> supplychain.UpdateCustomerRequest
+	84,  // 124: supplychain.SupplyChain.DeleteCustomer:input_type -> supplychain.DeleteCustomerRequest
+	86,  // 125: supplychain.SupplyChain.ListCustomers:input_type -> supplychain.ListCustomersRequest
+	88,  // 126: supplychain.SupplyChain.AddCustomerAddress:input_type -> supplychain.AddCustomerAddressRequest
+	90,  // 127: supplychain.SupplyChain.RemoveCustomerAddress:input_type -> supplychain.RemoveCustomerAddressRequest
+	66,  // 128: supplychain.SupplyChain.AddExchangeRates:input_type -> supplychain.AddExchangeRatesRequest
+	68,  // 129: supplychain.SupplyChain.ImportExchangeRates:input_type -> supplychain.ImportExchangeRatesRequest
+	70,  // 130: supplychain.SupplyChain.ListExchangeRates:input_type -> supplychain.ListExchangeRatesRequest
+	72,  // 131: supplychain.SupplyChain.CreatePromotion:input_type -> supplychain.CreatePromotionRequest
+	74,  // 132: supplychain.SupplyChain.ListPromotions:input_type -> supplychain.ListPromotionsRequest
+	76,  // 133: supplychain.SupplyChain.DeactivatePromotion:input_type -> supplychain.DeactivatePromotionRequest
+	122, // 134: supplychain.SupplyChain.AuditLogs:input_type -> supplychain.AuditLogsRequest
+	93,  // 135: supplychain.SupplyChain.CreateApiKey:input_type -> supplychain.CreateApiKeyRequest
+	95,  // 136: supplychain.SupplyChain.ListApiKeys:input_type -> supplychain.ListApiKeysRequest
+	97,  // 137: supplychain.SupplyChain.RevokeApiKey:input_type -> supplychain.RevokeApiKeyRequest
+	99,  // 138: supplychain.SupplyChain.RotateApiKey:input_type -> supplychain.RotateApiKeyRequest
+	103, // 139: supplychain.SupplyChain.ListRoles:input_type -> supplychain.ListRolesRequest
+	105, // 140: supplychain.SupplyChain.PutRole:input_type -> supplychain.PutRoleRequest
+	107, // 141: supplychain.SupplyChain.DeleteRole:input_type -> supplychain.DeleteRoleRequest
+	109, // 142: supplychain.SupplyChain.ListPermissions:input_type -> supplychain.ListPermissionsRequest
+	25,  // 143: supplychain.SupplyChain.CreateItem:output_type -> supplychain.CreateItemResponse
+	27,  // 144: supplychain.SupplyChain.UpdateItem:output_type -> supplychain.UpdateItemResponse
+	29,  // 145: supplychain.SupplyChain.DeleteItem:output_type -> supplychain.DeleteItemResponse
+	47,  // 146: supplychain.SupplyChain.ListItems:output_type -> supplychain.ListItemsResponse
+	111, // 147: supplychain.SupplyChain.WatchInventory:output_type -> supplychain.InventoryEvent
+	32,  // 148: supplychain.SupplyChain.ImportItems:output_type -> supplychain.ImportItemsResponse
+	115, // 149: supplychain.SupplyChain.BatchUpdateItems:output_type -> supplychain.BatchUpdateItemsResponse
+	34,  // 150: supplychain.SupplyChain.CreateOrder:output_type -> supplychain.CreateOrderResponse
+	36,  // 151: supplychain.SupplyChain.FulfillOrder:output_type -> supplychain.FulfillOrderResponse
+	49,  // 152: supplychain.SupplyChain.GetOrder:output_type -> supplychain.GetOrderResponse
+	41,  // 153: supplychain.SupplyChain.CancelOrder:output_type -> supplychain.CancelOrderResponse
+	51,  // 154: supplychain.SupplyChain.ListOrders:output_type -> supplychain.ListOrdersResponse
+	17,  // 155: supplychain.SupplyChain.WatchOrder:output_type -> supplychain.OrderEvent
+	118, // 156: supplychain.SupplyChain.BatchGetOrders:output_type -> supplychain.BatchGetOrdersResponse
+	39,  // 157: supplychain.SupplyChain.PickSession:output_type -> supplychain.PickResponse
+	43,  // 158: supplychain.SupplyChain.CreateShipment:output_type -> supplychain.CreateShipmentResponse
+	45,  // 159: supplychain.SupplyChain.UpdateShipment:output_type -> supplychain.UpdateShipmentResponse
+	55,  // 160: supplychain.SupplyChain.GetShipment:output_type -> supplychain.GetShipmentResponse
+	65,  // 161: supplychain.SupplyChain.ListShipments:output_type -> supplychain.ListShipmentsResponse
+	19,  // 162: supplychain.SupplyChain.WatchShipment:output_type -> supplychain.ShipmentEvent
+	121, // 163: supplychain.SupplyChain.BatchUpdateShipments:output_type -> supplychain.BatchUpdateShipmentsResponse
+	57,  // 164: supplychain.SupplyChain.CreateReturn:output_type -> supplychain.CreateReturnResponse
+	59,  // 165: supplychain.SupplyChain.ApproveReturn:output_type -> supplychain.ApproveReturnResponse
+	61,  // 166: supplychain.SupplyChain.ReceiveReturn:output_type -> supplychain.ReceiveReturnResponse
+	63,  // 167: supplychain.SupplyChain.GetReturn:output_type -> supplychain.GetReturnResponse
+	79,  // 168: supplychain.SupplyChain.CreateCustomer:output_type -> supplychain.CreateCustomerResponse
+	81,  // 169: supplychain.SupplyChain.GetCustomer:output_type -> supplychain.GetCustomerResponse
+	83,  // 170: supplychain.SupplyChain.UpdateCustomer:output_type -> supplychain.UpdateCustomerResponse
+	85,  // 171: supplychain.SupplyChain.DeleteCustomer:output_type -> supplychain.DeleteCustomerResponse
+	87,  // 172: supplychain.SupplyChain.ListCustomers:output_type -> supplychain.ListCustomersResponse
+	89,  // 173: supplychain.SupplyChain.AddCustomerAddress:output_type -> supplychain.AddCustomerAddressResponse
+	91,  // 174: supplychain.SupplyChain.RemoveCustomerAddress:output_type -> supplychain.RemoveCustomerAddressResponse
+	67,  // 175: supplychain.SupplyChain.AddExchangeRates:output_type -> supplychain.AddExchangeRatesResponse
+	69,  // 176: supplychain.SupplyChain.ImportExchangeRates:output_type -> supplychain.ImportExchangeRatesResponse
+	71,  // 177: supplychain.SupplyChain.ListExchangeRates:output_type -> supplychain.ListExchangeRatesResponse
+	73,  // 178: supplychain.SupplyChain.CreatePromotion:output_type -> supplychain.CreatePromotionResponse
+	75,  // 179: supplychain.SupplyChain.ListPromotions:output_type -> supplychain.ListPromotionsResponse
+	77,  // 180: supplychain.SupplyChain.DeactivatePromotion:output_type -> supplychain.DeactivatePromotionResponse
+	124, // 181: supplychain.SupplyChain.AuditLogs:output_type -> supplychain.AuditLogsResponse
+	94,  // 182: supplychain.SupplyChain.CreateApiKey:output_type -> supplychain.CreateApiKeyResponse
+	96,  // 183: supplychain.SupplyChain.ListApiKeys:output_type -> supplychain.ListApiKeysResponse
+	98,  // 184: supplychain.SupplyChain.RevokeApiKey:output_type -> supplychain.RevokeApiKeyResponse
+	100, // 185: supplychain.SupplyChain.RotateApiKey:output_type -> supplychain.RotateApiKeyResponse
+	104, // 186: supplychain.SupplyChain.ListRoles:output_type -> supplychain.ListRolesResponse
+	106, // 187: supplychain.SupplyChain.PutRole:output_type -> supplychain.PutRoleResponse
+	108, // 188: supplychain.SupplyChain.DeleteRole:output_type -> supplychain.DeleteRoleResponse
+	110, // 189: supplychain.SupplyChain.ListPermissions:output_type -> supplychain.ListPermissionsResponse
+	143, // [143:190] is the sub-list for method output_type
+	96,  // [96:143] is the sub-list for method input_type
+	96,  // [96:96] is the sub-list for extension type_name
+	96,  // [96:96] is the sub-list for extension extendee
+	0,   // [0:96] is the sub-list for field type_name
 }
 
 func init() { file_supplychain_supplychain_proto_init() }
@@ -1932,13 +8534,14 @@ func file_supplychain_supplychain_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_supplychain_supplychain_proto_rawDesc), len(file_supplychain_supplychain_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   28,
+			NumEnums:      7,
+			NumMessages:   118,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_supplychain_supplychain_proto_goTypes,
 		DependencyIndexes: file_supplychain_supplychain_proto_depIdxs,
+		EnumInfos:         file_supplychain_supplychain_proto_enumTypes,
 		MessageInfos:      file_supplychain_supplychain_proto_msgTypes,
 	}.Build()
 	File_supplychain_supplychain_proto = out.File
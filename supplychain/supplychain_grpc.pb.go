@@ -19,17 +19,53 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SupplyChain_CreateItem_FullMethodName     = "/supplychain.SupplyChain/CreateItem"
-	SupplyChain_UpdateItem_FullMethodName     = "/supplychain.SupplyChain/UpdateItem"
-	SupplyChain_DeleteItem_FullMethodName     = "/supplychain.SupplyChain/DeleteItem"
-	SupplyChain_ListItems_FullMethodName      = "/supplychain.SupplyChain/ListItems"
-	SupplyChain_CreateOrder_FullMethodName    = "/supplychain.SupplyChain/CreateOrder"
-	SupplyChain_FulfillOrder_FullMethodName   = "/supplychain.SupplyChain/FulfillOrder"
-	SupplyChain_GetOrder_FullMethodName       = "/supplychain.SupplyChain/GetOrder"
-	SupplyChain_CreateShipment_FullMethodName = "/supplychain.SupplyChain/CreateShipment"
-	SupplyChain_UpdateShipment_FullMethodName = "/supplychain.SupplyChain/UpdateShipment"
-	SupplyChain_ListShipments_FullMethodName  = "/supplychain.SupplyChain/ListShipments"
-	SupplyChain_AuditLogs_FullMethodName      = "/supplychain.SupplyChain/AuditLogs"
+	SupplyChain_CreateItem_FullMethodName            = "/supplychain.SupplyChain/CreateItem"
+	SupplyChain_UpdateItem_FullMethodName            = "/supplychain.SupplyChain/UpdateItem"
+	SupplyChain_DeleteItem_FullMethodName            = "/supplychain.SupplyChain/DeleteItem"
+	SupplyChain_ListItems_FullMethodName             = "/supplychain.SupplyChain/ListItems"
+	SupplyChain_WatchInventory_FullMethodName        = "/supplychain.SupplyChain/WatchInventory"
+	SupplyChain_ImportItems_FullMethodName           = "/supplychain.SupplyChain/ImportItems"
+	SupplyChain_BatchUpdateItems_FullMethodName      = "/supplychain.SupplyChain/BatchUpdateItems"
+	SupplyChain_CreateOrder_FullMethodName           = "/supplychain.SupplyChain/CreateOrder"
+	SupplyChain_FulfillOrder_FullMethodName          = "/supplychain.SupplyChain/FulfillOrder"
+	SupplyChain_GetOrder_FullMethodName              = "/supplychain.SupplyChain/GetOrder"
+	SupplyChain_CancelOrder_FullMethodName           = "/supplychain.SupplyChain/CancelOrder"
+	SupplyChain_ListOrders_FullMethodName            = "/supplychain.SupplyChain/ListOrders"
+	SupplyChain_WatchOrder_FullMethodName            = "/supplychain.SupplyChain/WatchOrder"
+	SupplyChain_BatchGetOrders_FullMethodName        = "/supplychain.SupplyChain/BatchGetOrders"
+	SupplyChain_PickSession_FullMethodName           = "/supplychain.SupplyChain/PickSession"
+	SupplyChain_CreateShipment_FullMethodName        = "/supplychain.SupplyChain/CreateShipment"
+	SupplyChain_UpdateShipment_FullMethodName        = "/supplychain.SupplyChain/UpdateShipment"
+	SupplyChain_GetShipment_FullMethodName           = "/supplychain.SupplyChain/GetShipment"
+	SupplyChain_ListShipments_FullMethodName         = "/supplychain.SupplyChain/ListShipments"
+	SupplyChain_WatchShipment_FullMethodName         = "/supplychain.SupplyChain/WatchShipment"
+	SupplyChain_BatchUpdateShipments_FullMethodName  = "/supplychain.SupplyChain/BatchUpdateShipments"
+	SupplyChain_CreateReturn_FullMethodName          = "/supplychain.SupplyChain/CreateReturn"
+	SupplyChain_ApproveReturn_FullMethodName         = "/supplychain.SupplyChain/ApproveReturn"
+	SupplyChain_ReceiveReturn_FullMethodName         = "/supplychain.SupplyChain/ReceiveReturn"
+	SupplyChain_GetReturn_FullMethodName             = "/supplychain.SupplyChain/GetReturn"
+	SupplyChain_CreateCustomer_FullMethodName        = "/supplychain.SupplyChain/CreateCustomer"
+	SupplyChain_GetCustomer_FullMethodName           = "/supplychain.SupplyChain/GetCustomer"
+	SupplyChain_UpdateCustomer_FullMethodName        = "/supplychain.SupplyChain/UpdateCustomer"
+	SupplyChain_DeleteCustomer_FullMethodName        = "/supplychain.SupplyChain/DeleteCustomer"
+	SupplyChain_ListCustomers_FullMethodName         = "/supplychain.SupplyChain/ListCustomers"
+	SupplyChain_AddCustomerAddress_FullMethodName    = "/supplychain.SupplyChain/AddCustomerAddress"
+	SupplyChain_RemoveCustomerAddress_FullMethodName = "/supplychain.SupplyChain/RemoveCustomerAddress"
+	SupplyChain_AddExchangeRates_FullMethodName      = "/supplychain.SupplyChain/AddExchangeRates"
+	SupplyChain_ImportExchangeRates_FullMethodName   = "/supplychain.SupplyChain/ImportExchangeRates"
+	SupplyChain_ListExchangeRates_FullMethodName     = "/supplychain.SupplyChain/ListExchangeRates"
+	SupplyChain_CreatePromotion_FullMethodName       = "/supplychain.SupplyChain/CreatePromotion"
+	SupplyChain_ListPromotions_FullMethodName        = "/supplychain.SupplyChain/ListPromotions"
+	SupplyChain_DeactivatePromotion_FullMethodName   = "/supplychain.SupplyChain/DeactivatePromotion"
+	SupplyChain_AuditLogs_FullMethodName             = "/supplychain.SupplyChain/AuditLogs"
+	SupplyChain_CreateApiKey_FullMethodName          = "/supplychain.SupplyChain/CreateApiKey"
+	SupplyChain_ListApiKeys_FullMethodName           = "/supplychain.SupplyChain/ListApiKeys"
+	SupplyChain_RevokeApiKey_FullMethodName          = "/supplychain.SupplyChain/RevokeApiKey"
+	SupplyChain_RotateApiKey_FullMethodName          = "/supplychain.SupplyChain/RotateApiKey"
+	SupplyChain_ListRoles_FullMethodName             = "/supplychain.SupplyChain/ListRoles"
+	SupplyChain_PutRole_FullMethodName               = "/supplychain.SupplyChain/PutRole"
+	SupplyChain_DeleteRole_FullMethodName            = "/supplychain.SupplyChain/DeleteRole"
+	SupplyChain_ListPermissions_FullMethodName       = "/supplychain.SupplyChain/ListPermissions"
 )
 
 // SupplyChainClient is the client API for SupplyChain service.
@@ -43,16 +79,58 @@ type SupplyChainClient interface {
 	UpdateItem(ctx context.Context, in *UpdateItemRequest, opts ...grpc.CallOption) (*UpdateItemResponse, error)
 	DeleteItem(ctx context.Context, in *DeleteItemRequest, opts ...grpc.CallOption) (*DeleteItemResponse, error)
 	ListItems(ctx context.Context, in *ListItemsRequest, opts ...grpc.CallOption) (*ListItemsResponse, error)
+	WatchInventory(ctx context.Context, in *WatchInventoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InventoryEvent], error)
+	ImportItems(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportItemsRequest, ImportItemsResponse], error)
+	BatchUpdateItems(ctx context.Context, in *BatchUpdateItemsRequest, opts ...grpc.CallOption) (*BatchUpdateItemsResponse, error)
 	// Order management
 	CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*CreateOrderResponse, error)
 	FulfillOrder(ctx context.Context, in *FulfillOrderRequest, opts ...grpc.CallOption) (*FulfillOrderResponse, error)
 	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
+	WatchOrder(ctx context.Context, in *WatchOrderRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderEvent], error)
+	BatchGetOrders(ctx context.Context, in *BatchGetOrdersRequest, opts ...grpc.CallOption) (*BatchGetOrdersResponse, error)
+	PickSession(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[PickRequest, PickResponse], error)
 	// Shipment management
 	CreateShipment(ctx context.Context, in *CreateShipmentRequest, opts ...grpc.CallOption) (*CreateShipmentResponse, error)
 	UpdateShipment(ctx context.Context, in *UpdateShipmentRequest, opts ...grpc.CallOption) (*UpdateShipmentResponse, error)
+	GetShipment(ctx context.Context, in *GetShipmentRequest, opts ...grpc.CallOption) (*GetShipmentResponse, error)
 	ListShipments(ctx context.Context, in *ListShipmentsRequest, opts ...grpc.CallOption) (*ListShipmentsResponse, error)
+	WatchShipment(ctx context.Context, in *WatchShipmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ShipmentEvent], error)
+	BatchUpdateShipments(ctx context.Context, in *BatchUpdateShipmentsRequest, opts ...grpc.CallOption) (*BatchUpdateShipmentsResponse, error)
+	// Returns
+	CreateReturn(ctx context.Context, in *CreateReturnRequest, opts ...grpc.CallOption) (*CreateReturnResponse, error)
+	ApproveReturn(ctx context.Context, in *ApproveReturnRequest, opts ...grpc.CallOption) (*ApproveReturnResponse, error)
+	ReceiveReturn(ctx context.Context, in *ReceiveReturnRequest, opts ...grpc.CallOption) (*ReceiveReturnResponse, error)
+	GetReturn(ctx context.Context, in *GetReturnRequest, opts ...grpc.CallOption) (*GetReturnResponse, error)
+	// Customers
+	CreateCustomer(ctx context.Context, in *CreateCustomerRequest, opts ...grpc.CallOption) (*CreateCustomerResponse, error)
+	GetCustomer(ctx context.Context, in *GetCustomerRequest, opts ...grpc.CallOption) (*GetCustomerResponse, error)
+	UpdateCustomer(ctx context.Context, in *UpdateCustomerRequest, opts ...grpc.CallOption) (*UpdateCustomerResponse, error)
+	DeleteCustomer(ctx context.Context, in *DeleteCustomerRequest, opts ...grpc.CallOption) (*DeleteCustomerResponse, error)
+	ListCustomers(ctx context.Context, in *ListCustomersRequest, opts ...grpc.CallOption) (*ListCustomersResponse, error)
+	AddCustomerAddress(ctx context.Context, in *AddCustomerAddressRequest, opts ...grpc.CallOption) (*AddCustomerAddressResponse, error)
+	RemoveCustomerAddress(ctx context.Context, in *RemoveCustomerAddressRequest, opts ...grpc.CallOption) (*RemoveCustomerAddressResponse, error)
+	// Exchange rates
+	AddExchangeRates(ctx context.Context, in *AddExchangeRatesRequest, opts ...grpc.CallOption) (*AddExchangeRatesResponse, error)
+	ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...grpc.CallOption) (*ImportExchangeRatesResponse, error)
+	ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error)
+	// Promotions
+	CreatePromotion(ctx context.Context, in *CreatePromotionRequest, opts ...grpc.CallOption) (*CreatePromotionResponse, error)
+	ListPromotions(ctx context.Context, in *ListPromotionsRequest, opts ...grpc.CallOption) (*ListPromotionsResponse, error)
+	DeactivatePromotion(ctx context.Context, in *DeactivatePromotionRequest, opts ...grpc.CallOption) (*DeactivatePromotionResponse, error)
 	// audit logs
 	AuditLogs(ctx context.Context, in *AuditLogsRequest, opts ...grpc.CallOption) (*AuditLogsResponse, error)
+	// API keys
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error)
+	RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...grpc.CallOption) (*RotateApiKeyResponse, error)
+	// Roles and permissions
+	ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error)
+	PutRole(ctx context.Context, in *PutRoleRequest, opts ...grpc.CallOption) (*PutRoleResponse, error)
+	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error)
+	ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error)
 }
 
 type supplyChainClient struct {
@@ -103,6 +181,48 @@ func (c *supplyChainClient) ListItems(ctx context.Context, in *ListItemsRequest,
 	return out, nil
 }
 
+func (c *supplyChainClient) WatchInventory(ctx context.Context, in *WatchInventoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InventoryEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SupplyChain_ServiceDesc.Streams[0], SupplyChain_WatchInventory_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchInventoryRequest, InventoryEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SupplyChain_WatchInventoryClient = grpc.ServerStreamingClient[InventoryEvent]
+
+func (c *supplyChainClient) ImportItems(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportItemsRequest, ImportItemsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SupplyChain_ServiceDesc.Streams[1], SupplyChain_ImportItems_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportItemsRequest, ImportItemsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SupplyChain_ImportItemsClient = grpc.ClientStreamingClient[ImportItemsRequest, ImportItemsResponse]
+
+func (c *supplyChainClient) BatchUpdateItems(ctx context.Context, in *BatchUpdateItemsRequest, opts ...grpc.CallOption) (*BatchUpdateItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdateItemsResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_BatchUpdateItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *supplyChainClient) CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*CreateOrderResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateOrderResponse)
@@ -133,6 +253,68 @@ func (c *supplyChainClient) GetOrder(ctx context.Context, in *GetOrderRequest, o
 	return out, nil
 }
 
+func (c *supplyChainClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelOrderResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_CancelOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrdersResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_ListOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) WatchOrder(ctx context.Context, in *WatchOrderRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SupplyChain_ServiceDesc.Streams[2], SupplyChain_WatchOrder_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchOrderRequest, OrderEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SupplyChain_WatchOrderClient = grpc.ServerStreamingClient[OrderEvent]
+
+func (c *supplyChainClient) BatchGetOrders(ctx context.Context, in *BatchGetOrdersRequest, opts ...grpc.CallOption) (*BatchGetOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetOrdersResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_BatchGetOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) PickSession(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[PickRequest, PickResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SupplyChain_ServiceDesc.Streams[3], SupplyChain_PickSession_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[PickRequest, PickResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SupplyChain_PickSessionClient = grpc.BidiStreamingClient[PickRequest, PickResponse]
+
 func (c *supplyChainClient) CreateShipment(ctx context.Context, in *CreateShipmentRequest, opts ...grpc.CallOption) (*CreateShipmentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateShipmentResponse)
@@ -153,6 +335,16 @@ func (c *supplyChainClient) UpdateShipment(ctx context.Context, in *UpdateShipme
 	return out, nil
 }
 
+func (c *supplyChainClient) GetShipment(ctx context.Context, in *GetShipmentRequest, opts ...grpc.CallOption) (*GetShipmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShipmentResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_GetShipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *supplyChainClient) ListShipments(ctx context.Context, in *ListShipmentsRequest, opts ...grpc.CallOption) (*ListShipmentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListShipmentsResponse)
@@ -163,295 +355,1329 @@ func (c *supplyChainClient) ListShipments(ctx context.Context, in *ListShipments
 	return out, nil
 }
 
-func (c *supplyChainClient) AuditLogs(ctx context.Context, in *AuditLogsRequest, opts ...grpc.CallOption) (*AuditLogsResponse, error) {
+func (c *supplyChainClient) WatchShipment(ctx context.Context, in *WatchShipmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ShipmentEvent], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AuditLogsResponse)
-	err := c.cc.Invoke(ctx, SupplyChain_AuditLogs_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SupplyChain_ServiceDesc.Streams[4], SupplyChain_WatchShipment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchShipmentRequest, ShipmentEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SupplyChain_WatchShipmentClient = grpc.ServerStreamingClient[ShipmentEvent]
+
+func (c *supplyChainClient) BatchUpdateShipments(ctx context.Context, in *BatchUpdateShipmentsRequest, opts ...grpc.CallOption) (*BatchUpdateShipmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdateShipmentsResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_BatchUpdateShipments_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// SupplyChainServer is the server API for SupplyChain service.
-// All implementations must embed UnimplementedSupplyChainServer
-// for forward compatibility.
-//
-// SupplyChain service
-type SupplyChainServer interface {
-	// Inventory management
-	CreateItem(context.Context, *CreateItemRequest) (*CreateItemResponse, error)
-	UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error)
-	DeleteItem(context.Context, *DeleteItemRequest) (*DeleteItemResponse, error)
-	ListItems(context.Context, *ListItemsRequest) (*ListItemsResponse, error)
-	// Order management
-	CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error)
-	FulfillOrder(context.Context, *FulfillOrderRequest) (*FulfillOrderResponse, error)
-	GetOrder(context.Context, *GetOrderRequest) (*GetOrderResponse, error)
-	// Shipment management
-	CreateShipment(context.Context, *CreateShipmentRequest) (*CreateShipmentResponse, error)
-	UpdateShipment(context.Context, *UpdateShipmentRequest) (*UpdateShipmentResponse, error)
-	ListShipments(context.Context, *ListShipmentsRequest) (*ListShipmentsResponse, error)
-	// audit logs
-	AuditLogs(context.Context, *AuditLogsRequest) (*AuditLogsResponse, error)
-	mustEmbedUnimplementedSupplyChainServer()
+func (c *supplyChainClient) CreateReturn(ctx context.Context, in *CreateReturnRequest, opts ...grpc.CallOption) (*CreateReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReturnResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_CreateReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedSupplyChainServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedSupplyChainServer struct{}
+func (c *supplyChainClient) ApproveReturn(ctx context.Context, in *ApproveReturnRequest, opts ...grpc.CallOption) (*ApproveReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveReturnResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_ApproveReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedSupplyChainServer) CreateItem(context.Context, *CreateItemRequest) (*CreateItemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateItem not implemented")
+func (c *supplyChainClient) ReceiveReturn(ctx context.Context, in *ReceiveReturnRequest, opts ...grpc.CallOption) (*ReceiveReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReceiveReturnResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_ReceiveReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSupplyChainServer) UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateItem not implemented")
+
+func (c *supplyChainClient) GetReturn(ctx context.Context, in *GetReturnRequest, opts ...grpc.CallOption) (*GetReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReturnResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_GetReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSupplyChainServer) DeleteItem(context.Context, *DeleteItemRequest) (*DeleteItemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteItem not implemented")
+
+func (c *supplyChainClient) CreateCustomer(ctx context.Context, in *CreateCustomerRequest, opts ...grpc.CallOption) (*CreateCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCustomerResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_CreateCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSupplyChainServer) ListItems(context.Context, *ListItemsRequest) (*ListItemsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListItems not implemented")
+
+func (c *supplyChainClient) GetCustomer(ctx context.Context, in *GetCustomerRequest, opts ...grpc.CallOption) (*GetCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCustomerResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_GetCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSupplyChainServer) CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
+
+func (c *supplyChainClient) UpdateCustomer(ctx context.Context, in *UpdateCustomerRequest, opts ...grpc.CallOption) (*UpdateCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCustomerResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_UpdateCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) DeleteCustomer(ctx context.Context, in *DeleteCustomerRequest, opts ...grpc.CallOption) (*DeleteCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCustomerResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_DeleteCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) ListCustomers(ctx context.Context, in *ListCustomersRequest, opts ...grpc.CallOption) (*ListCustomersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCustomersResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_ListCustomers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) AddCustomerAddress(ctx context.Context, in *AddCustomerAddressRequest, opts ...grpc.CallOption) (*AddCustomerAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCustomerAddressResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_AddCustomerAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) RemoveCustomerAddress(ctx context.Context, in *RemoveCustomerAddressRequest, opts ...grpc.CallOption) (*RemoveCustomerAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveCustomerAddressResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_RemoveCustomerAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) AddExchangeRates(ctx context.Context, in *AddExchangeRatesRequest, opts ...grpc.CallOption) (*AddExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_AddExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...grpc.CallOption) (*ImportExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_ImportExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_ListExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) CreatePromotion(ctx context.Context, in *CreatePromotionRequest, opts ...grpc.CallOption) (*CreatePromotionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePromotionResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_CreatePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) ListPromotions(ctx context.Context, in *ListPromotionsRequest, opts ...grpc.CallOption) (*ListPromotionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromotionsResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_ListPromotions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) DeactivatePromotion(ctx context.Context, in *DeactivatePromotionRequest, opts ...grpc.CallOption) (*DeactivatePromotionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivatePromotionResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_DeactivatePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) AuditLogs(ctx context.Context, in *AuditLogsRequest, opts ...grpc.CallOption) (*AuditLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditLogsResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_AuditLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_CreateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_ListApiKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeApiKeyResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_RevokeApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...grpc.CallOption) (*RotateApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateApiKeyResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_RotateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRolesResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_ListRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) PutRole(ctx context.Context, in *PutRoleRequest, opts ...grpc.CallOption) (*PutRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutRoleResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_PutRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRoleResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_DeleteRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supplyChainClient) ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPermissionsResponse)
+	err := c.cc.Invoke(ctx, SupplyChain_ListPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SupplyChainServer is the server API for SupplyChain service.
+// All implementations must embed UnimplementedSupplyChainServer
+// for forward compatibility.
+//
+// SupplyChain service
+type SupplyChainServer interface {
+	// Inventory management
+	CreateItem(context.Context, *CreateItemRequest) (*CreateItemResponse, error)
+	UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error)
+	DeleteItem(context.Context, *DeleteItemRequest) (*DeleteItemResponse, error)
+	ListItems(context.Context, *ListItemsRequest) (*ListItemsResponse, error)
+	WatchInventory(*WatchInventoryRequest, grpc.ServerStreamingServer[InventoryEvent]) error
+	ImportItems(grpc.ClientStreamingServer[ImportItemsRequest, ImportItemsResponse]) error
+	BatchUpdateItems(context.Context, *BatchUpdateItemsRequest) (*BatchUpdateItemsResponse, error)
+	// Order management
+	CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error)
+	FulfillOrder(context.Context, *FulfillOrderRequest) (*FulfillOrderResponse, error)
+	GetOrder(context.Context, *GetOrderRequest) (*GetOrderResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
+	WatchOrder(*WatchOrderRequest, grpc.ServerStreamingServer[OrderEvent]) error
+	BatchGetOrders(context.Context, *BatchGetOrdersRequest) (*BatchGetOrdersResponse, error)
+	PickSession(grpc.BidiStreamingServer[PickRequest, PickResponse]) error
+	// Shipment management
+	CreateShipment(context.Context, *CreateShipmentRequest) (*CreateShipmentResponse, error)
+	UpdateShipment(context.Context, *UpdateShipmentRequest) (*UpdateShipmentResponse, error)
+	GetShipment(context.Context, *GetShipmentRequest) (*GetShipmentResponse, error)
+	ListShipments(context.Context, *ListShipmentsRequest) (*ListShipmentsResponse, error)
+	WatchShipment(*WatchShipmentRequest, grpc.ServerStreamingServer[ShipmentEvent]) error
+	BatchUpdateShipments(context.Context, *BatchUpdateShipmentsRequest) (*BatchUpdateShipmentsResponse, error)
+	// Returns
+	CreateReturn(context.Context, *CreateReturnRequest) (*CreateReturnResponse, error)
+	ApproveReturn(context.Context, *ApproveReturnRequest) (*ApproveReturnResponse, error)
+	ReceiveReturn(context.Context, *ReceiveReturnRequest) (*ReceiveReturnResponse, error)
+	GetReturn(context.Context, *GetReturnRequest) (*GetReturnResponse, error)
+	// Customers
+	CreateCustomer(context.Context, *CreateCustomerRequest) (*CreateCustomerResponse, error)
+	GetCustomer(context.Context, *GetCustomerRequest) (*GetCustomerResponse, error)
+	UpdateCustomer(context.Context, *UpdateCustomerRequest) (*UpdateCustomerResponse, error)
+	DeleteCustomer(context.Context, *DeleteCustomerRequest) (*DeleteCustomerResponse, error)
+	ListCustomers(context.Context, *ListCustomersRequest) (*ListCustomersResponse, error)
+	AddCustomerAddress(context.Context, *AddCustomerAddressRequest) (*AddCustomerAddressResponse, error)
+	RemoveCustomerAddress(context.Context, *RemoveCustomerAddressRequest) (*RemoveCustomerAddressResponse, error)
+	// Exchange rates
+	AddExchangeRates(context.Context, *AddExchangeRatesRequest) (*AddExchangeRatesResponse, error)
+	ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ImportExchangeRatesResponse, error)
+	ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error)
+	// Promotions
+	CreatePromotion(context.Context, *CreatePromotionRequest) (*CreatePromotionResponse, error)
+	ListPromotions(context.Context, *ListPromotionsRequest) (*ListPromotionsResponse, error)
+	DeactivatePromotion(context.Context, *DeactivatePromotionRequest) (*DeactivatePromotionResponse, error)
+	// audit logs
+	AuditLogs(context.Context, *AuditLogsRequest) (*AuditLogsResponse, error)
+	// API keys
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error)
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error)
+	RotateApiKey(context.Context, *RotateApiKeyRequest) (*RotateApiKeyResponse, error)
+	// Roles and permissions
+	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
+	PutRole(context.Context, *PutRoleRequest) (*PutRoleResponse, error)
+	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error)
+	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
+	mustEmbedUnimplementedSupplyChainServer()
+}
+
+// UnimplementedSupplyChainServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSupplyChainServer struct{}
+
+func (UnimplementedSupplyChainServer) CreateItem(context.Context, *CreateItemRequest) (*CreateItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateItem not implemented")
+}
+func (UnimplementedSupplyChainServer) UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateItem not implemented")
+}
+func (UnimplementedSupplyChainServer) DeleteItem(context.Context, *DeleteItemRequest) (*DeleteItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteItem not implemented")
+}
+func (UnimplementedSupplyChainServer) ListItems(context.Context, *ListItemsRequest) (*ListItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItems not implemented")
+}
+func (UnimplementedSupplyChainServer) WatchInventory(*WatchInventoryRequest, grpc.ServerStreamingServer[InventoryEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchInventory not implemented")
+}
+func (UnimplementedSupplyChainServer) ImportItems(grpc.ClientStreamingServer[ImportItemsRequest, ImportItemsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportItems not implemented")
+}
+func (UnimplementedSupplyChainServer) BatchUpdateItems(context.Context, *BatchUpdateItemsRequest) (*BatchUpdateItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateItems not implemented")
+}
+func (UnimplementedSupplyChainServer) CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
+}
+func (UnimplementedSupplyChainServer) FulfillOrder(context.Context, *FulfillOrderRequest) (*FulfillOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrder not implemented")
+}
+func (UnimplementedSupplyChainServer) GetOrder(context.Context, *GetOrderRequest) (*GetOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (UnimplementedSupplyChainServer) CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedSupplyChainServer) ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+}
+func (UnimplementedSupplyChainServer) WatchOrder(*WatchOrderRequest, grpc.ServerStreamingServer[OrderEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOrder not implemented")
+}
+func (UnimplementedSupplyChainServer) BatchGetOrders(context.Context, *BatchGetOrdersRequest) (*BatchGetOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetOrders not implemented")
+}
+func (UnimplementedSupplyChainServer) PickSession(grpc.BidiStreamingServer[PickRequest, PickResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method PickSession not implemented")
+}
+func (UnimplementedSupplyChainServer) CreateShipment(context.Context, *CreateShipmentRequest) (*CreateShipmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShipment not implemented")
+}
+func (UnimplementedSupplyChainServer) UpdateShipment(context.Context, *UpdateShipmentRequest) (*UpdateShipmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateShipment not implemented")
+}
+func (UnimplementedSupplyChainServer) GetShipment(context.Context, *GetShipmentRequest) (*GetShipmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShipment not implemented")
+}
+func (UnimplementedSupplyChainServer) ListShipments(context.Context, *ListShipmentsRequest) (*ListShipmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShipments not implemented")
+}
+func (UnimplementedSupplyChainServer) WatchShipment(*WatchShipmentRequest, grpc.ServerStreamingServer[ShipmentEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchShipment not implemented")
+}
+func (UnimplementedSupplyChainServer) BatchUpdateShipments(context.Context, *BatchUpdateShipmentsRequest) (*BatchUpdateShipmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateShipments not implemented")
+}
+func (UnimplementedSupplyChainServer) CreateReturn(context.Context, *CreateReturnRequest) (*CreateReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReturn not implemented")
+}
+func (UnimplementedSupplyChainServer) ApproveReturn(context.Context, *ApproveReturnRequest) (*ApproveReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveReturn not implemented")
+}
+func (UnimplementedSupplyChainServer) ReceiveReturn(context.Context, *ReceiveReturnRequest) (*ReceiveReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveReturn not implemented")
+}
+func (UnimplementedSupplyChainServer) GetReturn(context.Context, *GetReturnRequest) (*GetReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReturn not implemented")
+}
+func (UnimplementedSupplyChainServer) CreateCustomer(context.Context, *CreateCustomerRequest) (*CreateCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCustomer not implemented")
+}
+func (UnimplementedSupplyChainServer) GetCustomer(context.Context, *GetCustomerRequest) (*GetCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomer not implemented")
+}
+func (UnimplementedSupplyChainServer) UpdateCustomer(context.Context, *UpdateCustomerRequest) (*UpdateCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomer not implemented")
+}
+func (UnimplementedSupplyChainServer) DeleteCustomer(context.Context, *DeleteCustomerRequest) (*DeleteCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomer not implemented")
+}
+func (UnimplementedSupplyChainServer) ListCustomers(context.Context, *ListCustomersRequest) (*ListCustomersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCustomers not implemented")
+}
+func (UnimplementedSupplyChainServer) AddCustomerAddress(context.Context, *AddCustomerAddressRequest) (*AddCustomerAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCustomerAddress not implemented")
+}
+func (UnimplementedSupplyChainServer) RemoveCustomerAddress(context.Context, *RemoveCustomerAddressRequest) (*RemoveCustomerAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCustomerAddress not implemented")
+}
+func (UnimplementedSupplyChainServer) AddExchangeRates(context.Context, *AddExchangeRatesRequest) (*AddExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExchangeRates not implemented")
+}
+func (UnimplementedSupplyChainServer) ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ImportExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportExchangeRates not implemented")
+}
+func (UnimplementedSupplyChainServer) ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExchangeRates not implemented")
+}
+func (UnimplementedSupplyChainServer) CreatePromotion(context.Context, *CreatePromotionRequest) (*CreatePromotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromotion not implemented")
+}
+func (UnimplementedSupplyChainServer) ListPromotions(context.Context, *ListPromotionsRequest) (*ListPromotionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromotions not implemented")
+}
+func (UnimplementedSupplyChainServer) DeactivatePromotion(context.Context, *DeactivatePromotionRequest) (*DeactivatePromotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivatePromotion not implemented")
+}
+func (UnimplementedSupplyChainServer) AuditLogs(context.Context, *AuditLogsRequest) (*AuditLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditLogs not implemented")
+}
+func (UnimplementedSupplyChainServer) CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedSupplyChainServer) ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (UnimplementedSupplyChainServer) RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (UnimplementedSupplyChainServer) RotateApiKey(context.Context, *RotateApiKeyRequest) (*RotateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateApiKey not implemented")
+}
+func (UnimplementedSupplyChainServer) ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (UnimplementedSupplyChainServer) PutRole(context.Context, *PutRoleRequest) (*PutRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutRole not implemented")
+}
+func (UnimplementedSupplyChainServer) DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+}
+func (UnimplementedSupplyChainServer) ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPermissions not implemented")
+}
+func (UnimplementedSupplyChainServer) mustEmbedUnimplementedSupplyChainServer() {}
+func (UnimplementedSupplyChainServer) testEmbeddedByValue()                     {}
+
+// UnsafeSupplyChainServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SupplyChainServer will
+// result in compilation errors.
+type UnsafeSupplyChainServer interface {
+	mustEmbedUnimplementedSupplyChainServer()
+}
+
+func RegisterSupplyChainServer(s grpc.ServiceRegistrar, srv SupplyChainServer) {
+	// If the following call pancis, it indicates UnimplementedSupplyChainServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SupplyChain_ServiceDesc, srv)
+}
+
+func _SupplyChain_CreateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).CreateItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_CreateItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).CreateItem(ctx, req.(*CreateItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_UpdateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).UpdateItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_UpdateItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).UpdateItem(ctx, req.(*UpdateItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_DeleteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).DeleteItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_DeleteItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).DeleteItem(ctx, req.(*DeleteItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_ListItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).ListItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_ListItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).ListItems(ctx, req.(*ListItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_WatchInventory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchInventoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SupplyChainServer).WatchInventory(m, &grpc.GenericServerStream[WatchInventoryRequest, InventoryEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SupplyChain_WatchInventoryServer = grpc.ServerStreamingServer[InventoryEvent]
+
+func _SupplyChain_ImportItems_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SupplyChainServer).ImportItems(&grpc.GenericServerStream[ImportItemsRequest, ImportItemsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SupplyChain_ImportItemsServer = grpc.ClientStreamingServer[ImportItemsRequest, ImportItemsResponse]
+
+func _SupplyChain_BatchUpdateItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).BatchUpdateItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_BatchUpdateItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).BatchUpdateItems(ctx, req.(*BatchUpdateItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).CreateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_CreateOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).CreateOrder(ctx, req.(*CreateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_FulfillOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FulfillOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).FulfillOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_FulfillOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).FulfillOrder(ctx, req.(*FulfillOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_GetOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).GetOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_ListOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).ListOrders(ctx, req.(*ListOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_WatchOrder_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOrderRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SupplyChainServer).WatchOrder(m, &grpc.GenericServerStream[WatchOrderRequest, OrderEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SupplyChain_WatchOrderServer = grpc.ServerStreamingServer[OrderEvent]
+
+func _SupplyChain_BatchGetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).BatchGetOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_BatchGetOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).BatchGetOrders(ctx, req.(*BatchGetOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_PickSession_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SupplyChainServer).PickSession(&grpc.GenericServerStream[PickRequest, PickResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SupplyChain_PickSessionServer = grpc.BidiStreamingServer[PickRequest, PickResponse]
+
+func _SupplyChain_CreateShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).CreateShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_CreateShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).CreateShipment(ctx, req.(*CreateShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_UpdateShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).UpdateShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_UpdateShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).UpdateShipment(ctx, req.(*UpdateShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_GetShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).GetShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_GetShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).GetShipment(ctx, req.(*GetShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_ListShipments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShipmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).ListShipments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_ListShipments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).ListShipments(ctx, req.(*ListShipmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_WatchShipment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchShipmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SupplyChainServer).WatchShipment(m, &grpc.GenericServerStream[WatchShipmentRequest, ShipmentEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SupplyChain_WatchShipmentServer = grpc.ServerStreamingServer[ShipmentEvent]
+
+func _SupplyChain_BatchUpdateShipments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateShipmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).BatchUpdateShipments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_BatchUpdateShipments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).BatchUpdateShipments(ctx, req.(*BatchUpdateShipmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_CreateReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).CreateReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_CreateReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).CreateReturn(ctx, req.(*CreateReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_ApproveReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).ApproveReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_ApproveReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).ApproveReturn(ctx, req.(*ApproveReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_ReceiveReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).ReceiveReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_ReceiveReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).ReceiveReturn(ctx, req.(*ReceiveReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_GetReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).GetReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_GetReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).GetReturn(ctx, req.(*GetReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_CreateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).CreateCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_CreateCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).CreateCustomer(ctx, req.(*CreateCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_GetCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).GetCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_GetCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).GetCustomer(ctx, req.(*GetCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupplyChain_UpdateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).UpdateCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_UpdateCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).UpdateCustomer(ctx, req.(*UpdateCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSupplyChainServer) FulfillOrder(context.Context, *FulfillOrderRequest) (*FulfillOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrder not implemented")
+
+func _SupplyChain_DeleteCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).DeleteCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_DeleteCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).DeleteCustomer(ctx, req.(*DeleteCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSupplyChainServer) GetOrder(context.Context, *GetOrderRequest) (*GetOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+
+func _SupplyChain_ListCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCustomersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).ListCustomers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_ListCustomers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).ListCustomers(ctx, req.(*ListCustomersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSupplyChainServer) CreateShipment(context.Context, *CreateShipmentRequest) (*CreateShipmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateShipment not implemented")
+
+func _SupplyChain_AddCustomerAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCustomerAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).AddCustomerAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_AddCustomerAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).AddCustomerAddress(ctx, req.(*AddCustomerAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSupplyChainServer) UpdateShipment(context.Context, *UpdateShipmentRequest) (*UpdateShipmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateShipment not implemented")
+
+func _SupplyChain_RemoveCustomerAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCustomerAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).RemoveCustomerAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_RemoveCustomerAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).RemoveCustomerAddress(ctx, req.(*RemoveCustomerAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSupplyChainServer) ListShipments(context.Context, *ListShipmentsRequest) (*ListShipmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListShipments not implemented")
+
+func _SupplyChain_AddExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).AddExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_AddExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).AddExchangeRates(ctx, req.(*AddExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSupplyChainServer) AuditLogs(context.Context, *AuditLogsRequest) (*AuditLogsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuditLogs not implemented")
+
+func _SupplyChain_ImportExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).ImportExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_ImportExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).ImportExchangeRates(ctx, req.(*ImportExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSupplyChainServer) mustEmbedUnimplementedSupplyChainServer() {}
-func (UnimplementedSupplyChainServer) testEmbeddedByValue()                     {}
 
-// UnsafeSupplyChainServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SupplyChainServer will
-// result in compilation errors.
-type UnsafeSupplyChainServer interface {
-	mustEmbedUnimplementedSupplyChainServer()
+func _SupplyChain_ListExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupplyChainServer).ListExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_ListExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).ListExchangeRates(ctx, req.(*ListExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterSupplyChainServer(s grpc.ServiceRegistrar, srv SupplyChainServer) {
-	// If the following call pancis, it indicates UnimplementedSupplyChainServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _SupplyChain_CreatePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&SupplyChain_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(SupplyChainServer).CreatePromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupplyChain_CreatePromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupplyChainServer).CreatePromotion(ctx, req.(*CreatePromotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _SupplyChain_CreateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateItemRequest)
+func _SupplyChain_ListPromotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromotionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SupplyChainServer).CreateItem(ctx, in)
+		return srv.(SupplyChainServer).ListPromotions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SupplyChain_CreateItem_FullMethodName,
+		FullMethod: SupplyChain_ListPromotions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SupplyChainServer).CreateItem(ctx, req.(*CreateItemRequest))
+		return srv.(SupplyChainServer).ListPromotions(ctx, req.(*ListPromotionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SupplyChain_UpdateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateItemRequest)
+func _SupplyChain_DeactivatePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivatePromotionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SupplyChainServer).UpdateItem(ctx, in)
+		return srv.(SupplyChainServer).DeactivatePromotion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SupplyChain_UpdateItem_FullMethodName,
+		FullMethod: SupplyChain_DeactivatePromotion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SupplyChainServer).UpdateItem(ctx, req.(*UpdateItemRequest))
+		return srv.(SupplyChainServer).DeactivatePromotion(ctx, req.(*DeactivatePromotionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SupplyChain_DeleteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteItemRequest)
+func _SupplyChain_AuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SupplyChainServer).DeleteItem(ctx, in)
+		return srv.(SupplyChainServer).AuditLogs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SupplyChain_DeleteItem_FullMethodName,
+		FullMethod: SupplyChain_AuditLogs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SupplyChainServer).DeleteItem(ctx, req.(*DeleteItemRequest))
+		return srv.(SupplyChainServer).AuditLogs(ctx, req.(*AuditLogsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SupplyChain_ListItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListItemsRequest)
+func _SupplyChain_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SupplyChainServer).ListItems(ctx, in)
+		return srv.(SupplyChainServer).CreateApiKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SupplyChain_ListItems_FullMethodName,
+		FullMethod: SupplyChain_CreateApiKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SupplyChainServer).ListItems(ctx, req.(*ListItemsRequest))
+		return srv.(SupplyChainServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SupplyChain_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateOrderRequest)
+func _SupplyChain_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SupplyChainServer).CreateOrder(ctx, in)
+		return srv.(SupplyChainServer).ListApiKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SupplyChain_CreateOrder_FullMethodName,
+		FullMethod: SupplyChain_ListApiKeys_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SupplyChainServer).CreateOrder(ctx, req.(*CreateOrderRequest))
+		return srv.(SupplyChainServer).ListApiKeys(ctx, req.(*ListApiKeysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SupplyChain_FulfillOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FulfillOrderRequest)
+func _SupplyChain_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SupplyChainServer).FulfillOrder(ctx, in)
+		return srv.(SupplyChainServer).RevokeApiKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SupplyChain_FulfillOrder_FullMethodName,
+		FullMethod: SupplyChain_RevokeApiKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SupplyChainServer).FulfillOrder(ctx, req.(*FulfillOrderRequest))
+		return srv.(SupplyChainServer).RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SupplyChain_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderRequest)
+func _SupplyChain_RotateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateApiKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SupplyChainServer).GetOrder(ctx, in)
+		return srv.(SupplyChainServer).RotateApiKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SupplyChain_GetOrder_FullMethodName,
+		FullMethod: SupplyChain_RotateApiKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SupplyChainServer).GetOrder(ctx, req.(*GetOrderRequest))
+		return srv.(SupplyChainServer).RotateApiKey(ctx, req.(*RotateApiKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SupplyChain_CreateShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateShipmentRequest)
+func _SupplyChain_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SupplyChainServer).CreateShipment(ctx, in)
+		return srv.(SupplyChainServer).ListRoles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SupplyChain_CreateShipment_FullMethodName,
+		FullMethod: SupplyChain_ListRoles_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SupplyChainServer).CreateShipment(ctx, req.(*CreateShipmentRequest))
+		return srv.(SupplyChainServer).ListRoles(ctx, req.(*ListRolesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SupplyChain_UpdateShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateShipmentRequest)
+func _SupplyChain_PutRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SupplyChainServer).UpdateShipment(ctx, in)
+		return srv.(SupplyChainServer).PutRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SupplyChain_UpdateShipment_FullMethodName,
+		FullMethod: SupplyChain_PutRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SupplyChainServer).UpdateShipment(ctx, req.(*UpdateShipmentRequest))
+		return srv.(SupplyChainServer).PutRole(ctx, req.(*PutRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SupplyChain_ListShipments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListShipmentsRequest)
+func _SupplyChain_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SupplyChainServer).ListShipments(ctx, in)
+		return srv.(SupplyChainServer).DeleteRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SupplyChain_ListShipments_FullMethodName,
+		FullMethod: SupplyChain_DeleteRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SupplyChainServer).ListShipments(ctx, req.(*ListShipmentsRequest))
+		return srv.(SupplyChainServer).DeleteRole(ctx, req.(*DeleteRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SupplyChain_AuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuditLogsRequest)
+func _SupplyChain_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPermissionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SupplyChainServer).AuditLogs(ctx, in)
+		return srv.(SupplyChainServer).ListPermissions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SupplyChain_AuditLogs_FullMethodName,
+		FullMethod: SupplyChain_ListPermissions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SupplyChainServer).AuditLogs(ctx, req.(*AuditLogsRequest))
+		return srv.(SupplyChainServer).ListPermissions(ctx, req.(*ListPermissionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -479,6 +1705,10 @@ var SupplyChain_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListItems",
 			Handler:    _SupplyChain_ListItems_Handler,
 		},
+		{
+			MethodName: "BatchUpdateItems",
+			Handler:    _SupplyChain_BatchUpdateItems_Handler,
+		},
 		{
 			MethodName: "CreateOrder",
 			Handler:    _SupplyChain_CreateOrder_Handler,
@@ -491,6 +1721,18 @@ var SupplyChain_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOrder",
 			Handler:    _SupplyChain_GetOrder_Handler,
 		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _SupplyChain_CancelOrder_Handler,
+		},
+		{
+			MethodName: "ListOrders",
+			Handler:    _SupplyChain_ListOrders_Handler,
+		},
+		{
+			MethodName: "BatchGetOrders",
+			Handler:    _SupplyChain_BatchGetOrders_Handler,
+		},
 		{
 			MethodName: "CreateShipment",
 			Handler:    _SupplyChain_CreateShipment_Handler,
@@ -499,15 +1741,150 @@ var SupplyChain_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateShipment",
 			Handler:    _SupplyChain_UpdateShipment_Handler,
 		},
+		{
+			MethodName: "GetShipment",
+			Handler:    _SupplyChain_GetShipment_Handler,
+		},
 		{
 			MethodName: "ListShipments",
 			Handler:    _SupplyChain_ListShipments_Handler,
 		},
+		{
+			MethodName: "BatchUpdateShipments",
+			Handler:    _SupplyChain_BatchUpdateShipments_Handler,
+		},
+		{
+			MethodName: "CreateReturn",
+			Handler:    _SupplyChain_CreateReturn_Handler,
+		},
+		{
+			MethodName: "ApproveReturn",
+			Handler:    _SupplyChain_ApproveReturn_Handler,
+		},
+		{
+			MethodName: "ReceiveReturn",
+			Handler:    _SupplyChain_ReceiveReturn_Handler,
+		},
+		{
+			MethodName: "GetReturn",
+			Handler:    _SupplyChain_GetReturn_Handler,
+		},
+		{
+			MethodName: "CreateCustomer",
+			Handler:    _SupplyChain_CreateCustomer_Handler,
+		},
+		{
+			MethodName: "GetCustomer",
+			Handler:    _SupplyChain_GetCustomer_Handler,
+		},
+		{
+			MethodName: "UpdateCustomer",
+			Handler:    _SupplyChain_UpdateCustomer_Handler,
+		},
+		{
+			MethodName: "DeleteCustomer",
+			Handler:    _SupplyChain_DeleteCustomer_Handler,
+		},
+		{
+			MethodName: "ListCustomers",
+			Handler:    _SupplyChain_ListCustomers_Handler,
+		},
+		{
+			MethodName: "AddCustomerAddress",
+			Handler:    _SupplyChain_AddCustomerAddress_Handler,
+		},
+		{
+			MethodName: "RemoveCustomerAddress",
+			Handler:    _SupplyChain_RemoveCustomerAddress_Handler,
+		},
+		{
+			MethodName: "AddExchangeRates",
+			Handler:    _SupplyChain_AddExchangeRates_Handler,
+		},
+		{
+			MethodName: "ImportExchangeRates",
+			Handler:    _SupplyChain_ImportExchangeRates_Handler,
+		},
+		{
+			MethodName: "ListExchangeRates",
+			Handler:    _SupplyChain_ListExchangeRates_Handler,
+		},
+		{
+			MethodName: "CreatePromotion",
+			Handler:    _SupplyChain_CreatePromotion_Handler,
+		},
+		{
+			MethodName: "ListPromotions",
+			Handler:    _SupplyChain_ListPromotions_Handler,
+		},
+		{
+			MethodName: "DeactivatePromotion",
+			Handler:    _SupplyChain_DeactivatePromotion_Handler,
+		},
 		{
 			MethodName: "AuditLogs",
 			Handler:    _SupplyChain_AuditLogs_Handler,
 		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _SupplyChain_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _SupplyChain_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _SupplyChain_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "RotateApiKey",
+			Handler:    _SupplyChain_RotateApiKey_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _SupplyChain_ListRoles_Handler,
+		},
+		{
+			MethodName: "PutRole",
+			Handler:    _SupplyChain_PutRole_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _SupplyChain_DeleteRole_Handler,
+		},
+		{
+			MethodName: "ListPermissions",
+			Handler:    _SupplyChain_ListPermissions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchInventory",
+			Handler:       _SupplyChain_WatchInventory_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportItems",
+			Handler:       _SupplyChain_ImportItems_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchOrder",
+			Handler:       _SupplyChain_WatchOrder_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PickSession",
+			Handler:       _SupplyChain_PickSession_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchShipment",
+			Handler:       _SupplyChain_WatchShipment_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "supplychain/supplychain.proto",
 }
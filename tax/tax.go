@@ -0,0 +1,190 @@
+// Package tax works out the sales tax or VAT owed on order lines. Rates come
+// from a Calculator, Rules reads them from a local JSON file.
+package tax
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/Scrimzay/supplychain/money"
+)
+
+// DefaultCategory is the category of items that don't name one
+const DefaultCategory = "STANDARD"
+
+var (
+	ErrNoJurisdiction = errors.New("no tax rules for region")
+	ErrNoCategory     = errors.New("no tax rate for category")
+)
+
+// Line is an order line to tax, amount is unit price times quantity in minor units
+type Line struct {
+	Category string
+	Amount   int64
+}
+
+// LineTax is the tax on one line, net is the line amount without tax
+type LineTax struct {
+	Rate string
+	Tax  int64
+	Net  int64
+}
+
+// Result is the tax on every line of an order, in the order they were given
+type Result struct {
+	Jurisdiction     string
+	PricesIncludeTax bool
+	Lines            []LineTax
+}
+
+// Calculator works out the tax on the lines of an order shipping to a region,
+// e.g. "US-CA" or "DE"
+type Calculator interface {
+	Calculate(ctx context.Context, region string, lines []Line) (*Result, error)
+}
+
+// None charges no tax on anything
+type None struct{}
+
+func (None) Calculate(ctx context.Context, region string, lines []Line) (*Result, error) {
+	result := &Result{}
+	for _, line := range lines {
+		result.Lines = append(result.Lines, LineTax{Rate: "0", Net: line.Amount})
+	}
+	return result, nil
+}
+
+// Jurisdiction is the tax regime of a region. Prices there either already
+// include the tax (VAT in most of Europe) or have it added on top (US sales tax).
+type Jurisdiction struct {
+	Region           string            `json:"region"`
+	PricesIncludeTax bool              `json:"prices_include_tax"`
+	Rates            map[string]string `json:"rates"` // category -> decimal rate, e.g. "0.19"
+}
+
+// Rules calculates tax from a fixed set of jurisdictions. A region matches
+// its own entry, then its country ("US" for "US-CA"), then the "*" entry.
+type Rules struct {
+	jurisdictions map[string]*Jurisdiction
+	rates         map[string]map[string]*big.Rat
+}
+
+// rulesFile is the layout of a rules file
+type rulesFile struct {
+	Jurisdictions []*Jurisdiction `json:"jurisdictions"`
+}
+
+// LoadRules reads a rules file like
+//
+//	{"jurisdictions": [
+//		{"region": "US-CA", "rates": {"STANDARD": "0.0725", "FOOD": "0"}},
+//		{"region": "DE", "prices_include_tax": true, "rates": {"STANDARD": "0.19", "REDUCED": "0.07"}}
+//	]}
+func LoadRules(path string) (*Rules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file rulesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	rules, err := NewRules(file.Jurisdictions)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return rules, nil
+}
+
+// NewRules checks a set of jurisdictions and builds a calculator from them
+func NewRules(jurisdictions []*Jurisdiction) (*Rules, error) {
+	rules := &Rules{jurisdictions: map[string]*Jurisdiction{}, rates: map[string]map[string]*big.Rat{}}
+	for _, j := range jurisdictions {
+		region := strings.ToUpper(j.Region)
+		if region == "" {
+			return nil, errors.New("jurisdiction without a region")
+		}
+		if _, dup := rules.jurisdictions[region]; dup {
+			return nil, fmt.Errorf("region %s listed twice", region)
+		}
+		rates := map[string]*big.Rat{}
+		for category, s := range j.Rates {
+			rate, ok := new(big.Rat).SetString(s)
+			if !ok || rate.Sign() < 0 {
+				return nil, fmt.Errorf("region %s: invalid rate %q for %s", region, s, category)
+			}
+			rates[strings.ToUpper(category)] = rate
+		}
+		rules.jurisdictions[region] = j
+		rules.rates[region] = rates
+	}
+	return rules, nil
+}
+
+// lookup finds the jurisdiction a region falls under
+func (r *Rules) lookup(region string) (string, bool) {
+	region = strings.ToUpper(region)
+	if _, ok := r.jurisdictions[region]; ok {
+		return region, true
+	}
+	if country, _, ok := strings.Cut(region, "-"); ok {
+		if _, ok := r.jurisdictions[country]; ok {
+			return country, true
+		}
+	}
+	if _, ok := r.jurisdictions["*"]; ok {
+		return "*", true
+	}
+	return "", false
+}
+
+// Calculate taxes every line at its category's rate in the region's
+// jurisdiction, rounding per line. An empty region outside any "*" entry is untaxed.
+func (r *Rules) Calculate(ctx context.Context, region string, lines []Line) (*Result, error) {
+	key, ok := r.lookup(region)
+	if !ok {
+		if region == "" {
+			return None{}.Calculate(ctx, region, lines)
+		}
+		return nil, ErrNoJurisdiction
+	}
+	j := r.jurisdictions[key]
+
+	result := &Result{Jurisdiction: key, PricesIncludeTax: j.PricesIncludeTax}
+	for _, line := range lines {
+		category := strings.ToUpper(line.Category)
+		if category == "" {
+			category = DefaultCategory
+		}
+		rate, ok := r.rates[key][category]
+		if !ok {
+			return nil, fmt.Errorf("%w %s in %s", ErrNoCategory, category, key)
+		}
+
+		tax, err := Amount(line.Amount, rate, j.PricesIncludeTax)
+		if err != nil {
+			return nil, err
+		}
+		net := line.Amount
+		if j.PricesIncludeTax {
+			net -= tax
+		}
+		result.Lines = append(result.Lines, LineTax{Rate: money.FormatRate(rate), Tax: tax, Net: net})
+	}
+	return result, nil
+}
+
+// Amount is the tax on an amount at a rate. An inclusive amount already
+// contains the tax, which is then amount * rate / (1 + rate).
+func Amount(amount int64, rate *big.Rat, inclusive bool) (int64, error) {
+	tax := new(big.Rat).Mul(new(big.Rat).SetInt64(amount), rate)
+	if inclusive {
+		tax.Quo(tax, new(big.Rat).Add(big.NewRat(1, 1), rate))
+	}
+	return money.Round(tax)
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/big"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Scrimzay/supplychain/money"
+	"github.com/Scrimzay/supplychain/supplychain"
+	"github.com/Scrimzay/supplychain/tax"
+)
+
+// taxCategory normalizes the tax category given for an item
+func taxCategory(category string) string {
+	if category = strings.ToUpper(strings.TrimSpace(category)); category == "" {
+		return tax.DefaultCategory
+	}
+	return category
+}
+
+// taxOrder works out the tax on every line of a new order, after any
+// discounts, and fills in its subtotal, tax total and grand total
+func (s *SupplyChainServer) taxOrder(ctx context.Context, order *supplychain.Order) error {
+	lines := make([]tax.Line, len(order.Items))
+	for i, item := range order.Items {
+		lines[i] = tax.Line{Category: item.TaxCategory, Amount: item.LineTotal.Value - item.GetDiscount().GetValue()}
+	}
+
+	result, err := s.tax.Calculate(ctx, order.ShipToRegion, lines)
+	if errors.Is(err, tax.ErrNoJurisdiction) || errors.Is(err, tax.ErrNoCategory) {
+		return status.Errorf(codes.FailedPrecondition, "Cannot tax order shipping to %q: %v", order.ShipToRegion, err)
+	}
+	if err != nil {
+		return status.Error(codes.Internal, "Failed to calculate tax")
+	}
+
+	currency := order.Total.Currency
+	var subtotal, taxTotal int64
+	for i, item := range order.Items {
+		line := result.Lines[i]
+		item.Tax = formatAmount(ctx, &supplychain.Amount{Value: line.Tax, Currency: currency})
+		item.TaxRate = line.Rate
+		subtotal += line.Net
+		taxTotal += line.Tax
+	}
+	order.TaxJurisdiction = result.Jurisdiction
+	order.PricesIncludeTax = result.PricesIncludeTax
+	order.Subtotal = formatAmount(ctx, &supplychain.Amount{Value: subtotal, Currency: currency})
+	order.TaxTotal = formatAmount(ctx, &supplychain.Amount{Value: taxTotal, Currency: currency})
+	order.Total = formatAmount(ctx, &supplychain.Amount{Value: subtotal + taxTotal, Currency: currency})
+	return nil
+}
+
+// orderLineTax returns the share of an order line's tax that falls on
+// quantity of its units. Prices that include tax already carry it, so
+// only tax added on top is returned.
+func orderLineTax(ctx context.Context, tx *sql.Tx, orderID, itemID string, quantity int32) (int64, error) {
+	var lineTax sql.NullInt64
+	var lineQuantity int32
+	var inclusive sql.NullBool
+	err := tx.QueryRowContext(ctx, `
+		SELECT oi.tax_value, oi.quantity, o.prices_include_tax
+		FROM order_items oi JOIN orders o ON o.id = oi.order_id WHERE oi.order_id = ? AND oi.item_id = ?`,
+		orderID, itemID).Scan(&lineTax, &lineQuantity, &inclusive)
+	if err != nil {
+		return 0, err
+	}
+	if !lineTax.Valid || inclusive.Bool || lineQuantity == 0 {
+		return 0, nil
+	}
+	return money.Round(big.NewRat(lineTax.Int64*int64(quantity), int64(lineQuantity)))
+}
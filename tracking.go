@@ -0,0 +1,103 @@
+package main
+
+import (
+	"database/sql"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Scrimzay/supplychain/supplychain"
+)
+
+// orderFinal and shipmentFinal are the statuses a watch ends on
+var (
+	orderFinal    = map[string]bool{orderDelivered: true, orderCancelled: true}
+	shipmentFinal = map[string]bool{shipmentDelivered: true, shipmentReturned: true}
+)
+
+// WatchOrder streams an order's history and then its new events until the
+// order is delivered or cancelled
+func (s *SupplyChainServer) WatchOrder(req *supplychain.WatchOrderRequest, stream grpc.ServerStreamingServer[supplychain.OrderEvent]) error {
+	if req.Id == "" {
+		return status.Error(codes.InvalidArgument, "Order ID required")
+	}
+	ctx := stream.Context()
+	if err := checkOrderOwner(ctx, s.db, req.Id); err != nil {
+		return err
+	}
+
+	wake, cancel := s.orderUpdates.subscribe()
+	defer cancel()
+
+	after := req.AfterEventId
+	return follow(ctx, wake, func() (bool, error) {
+		// the status is read before the events, so once it is final every
+		// event up to it is in what follows
+		var current string
+		err := s.db.QueryRowContext(ctx, "SELECT status FROM orders WHERE id = ?", req.Id).Scan(&current)
+		if err == sql.ErrNoRows {
+			return false, status.Error(codes.NotFound, "Order not found")
+		}
+		if err != nil {
+			return false, status.Error(codes.Internal, "Failed to fetch order")
+		}
+
+		events, err := loadOrderHistory(ctx, s.db, req.Id, after)
+		if err != nil {
+			return false, status.Error(codes.Internal, "Failed to fetch order history")
+		}
+		for _, event := range events {
+			if err := stream.Send(event); err != nil {
+				return false, err
+			}
+			after = event.Id
+		}
+		return orderFinal[current], nil
+	})
+}
+
+// WatchShipment streams a shipment's tracking history and then new scans
+// until it is delivered or returned
+func (s *SupplyChainServer) WatchShipment(req *supplychain.WatchShipmentRequest, stream grpc.ServerStreamingServer[supplychain.ShipmentEvent]) error {
+	if req.Id == "" {
+		return status.Error(codes.InvalidArgument, "Shipment ID required")
+	}
+	ctx := stream.Context()
+
+	var orderID string
+	err := s.db.QueryRowContext(ctx, "SELECT order_id FROM shipments WHERE id = ?", req.Id).Scan(&orderID)
+	if err == sql.ErrNoRows {
+		return status.Error(codes.NotFound, "Shipment not found")
+	}
+	if err != nil {
+		return status.Error(codes.Internal, "Failed to fetch shipment")
+	}
+	if err := checkOrderOwner(ctx, s.db, orderID); err != nil {
+		return err
+	}
+
+	wake, cancel := s.orderUpdates.subscribe()
+	defer cancel()
+
+	after := req.AfterEventId
+	return follow(ctx, wake, func() (bool, error) {
+		var current string
+		err := s.db.QueryRowContext(ctx, "SELECT status FROM shipments WHERE id = ?", req.Id).Scan(&current)
+		if err != nil {
+			return false, status.Error(codes.Internal, "Failed to fetch shipment")
+		}
+
+		events, err := loadShipmentEvents(ctx, s.db, req.Id, after)
+		if err != nil {
+			return false, status.Error(codes.Internal, "Failed to fetch shipment events")
+		}
+		for _, event := range events {
+			if err := stream.Send(event); err != nil {
+				return false, err
+			}
+			after = event.Id
+		}
+		return shipmentFinal[current], nil
+	})
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/status"
+)
+
+// watchPoll is how often a stream looks for new events without being woken,
+// in case a change was committed by another process
+const watchPoll = 5 * time.Second
+
+// notifier wakes streams waiting for new events. Events themselves are
+// read from the database so a stream never misses one between wake-ups.
+type notifier struct {
+	mu      sync.Mutex
+	waiters map[chan struct{}]struct{}
+}
+
+func newNotifier() *notifier {
+	return &notifier{waiters: map[chan struct{}]struct{}{}}
+}
+
+// subscribe returns a channel signalled after every notify until cancel is
+// called. Signals don't queue up, one wake-up can stand for several events.
+func (n *notifier) subscribe() (wake <-chan struct{}, cancel func()) {
+	ch := make(chan struct{}, 1)
+	n.mu.Lock()
+	n.waiters[ch] = struct{}{}
+	n.mu.Unlock()
+	return ch, func() {
+		n.mu.Lock()
+		delete(n.waiters, ch)
+		n.mu.Unlock()
+	}
+}
+
+// notify wakes every subscriber, call it once the change is committed
+func (n *notifier) notify() {
+	if n == nil {
+		return
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for ch := range n.waiters {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// follow runs step until it reports the stream is done, waiting for a
+// wake-up or the next poll between runs
+func follow(ctx context.Context, wake <-chan struct{}, step func() (done bool, err error)) error {
+	for {
+		done, err := step()
+		if err != nil {
+			if ctx.Err() != nil {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-wake:
+		case <-time.After(watchPoll):
+		}
+	}
+}